@@ -6,6 +6,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -14,17 +15,27 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/maruel/serve-mp4/vid"
 	"github.com/maruel/serve-mp4/vid/ffmpeg"
 )
 
-const defaultFmt = "Fmt: {{.Container}}\nDur: {{.Duration}}\nVid: {{.VideoCodec}}\nAud: {{.AudioCodec}}\nLng: {{.AudioLang}}"
+const defaultFmt = "Fmt: {{.Container}}\nDur: {{.Duration}}\nVid: {{.VideoCodec}}{{if .HDR}} {{.HDR}}{{end}}\nRes: {{.Width}}x{{.Height}}@{{.FrameRate}}\nAud: {{.AudioCodec}}\nLng: {{.AudioLang}}"
 
 func mainImpl() error {
-	lang := flag.String("lang", "fre", "preferred language")
+	lang := flag.String("lang", "fre", "preferred languages, comma separated in order of preference, e.g. \"fre,eng,und\"")
+	video := flag.Int("video", -1, "video stream index to use instead of the automatically selected one")
+	idet := flag.Bool("idet", false, "analyze frames to detect interlacing when the field order is unknown")
+	ffmpegPath := flag.String("ffmpeg", "ffmpeg", "path to the ffmpeg executable")
+	ffprobePath := flag.String("ffprobe", "ffprobe", "path to the ffprobe executable")
+	timeout := flag.Duration("timeout", time.Minute, "maximum duration to analyze the file")
 	raw := flag.Bool("raw", false, "print raw JSON")
 	format := flag.String("fmt", defaultFmt, "format to use; an instance vid.Info")
+	device := flag.String("device", "", "print how the file would be transcoded for this device, e.g. \"ChromeCast\"")
+	profiles := flag.String("profiles", "", "JSON file with device profiles to add or override")
+	allAudio := flag.Bool("all-audio", false, "keep all the audio tracks with -device")
 	verbose := flag.Bool("v", false, "verbose")
 	log.SetFlags(log.Lmicroseconds)
 	flag.Parse()
@@ -34,9 +45,27 @@ func mainImpl() error {
 	if flag.NArg() != 1 {
 		return errors.New("expected a single file")
 	}
+	ffmpeg.Default = &ffmpeg.Commands{FFprobe: *ffprobePath, FFmpeg: *ffmpegPath}
+	if *profiles != "" {
+		if err := vid.LoadProfiles(*profiles); err != nil {
+			return err
+		}
+	}
+	var d vid.Device
+	if *device != "" {
+		var ok bool
+		if d, ok = vid.DeviceByName(*device); !ok {
+			return fmt.Errorf("unknown device %q", *device)
+		}
+		if *allAudio {
+			d.Profile().AllAudio = true
+		}
+	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
 	if *raw {
-		v, err := ffmpeg.ProbeRaw(flag.Args()[0])
+		v, err := ffmpeg.ProbeRaw(ctx, flag.Args()[0])
 		if err != nil {
 			return err
 		}
@@ -48,15 +77,33 @@ func mainImpl() error {
 		return err
 	}
 
-	v, err := vid.Identify(flag.Args()[0], *lang)
+	v, err := vid.Identify(ctx, flag.Args()[0], vid.SplitList(*lang))
 	if err != nil {
 		return err
 	}
+	if *video != -1 {
+		if err = v.SelectVideo(*video); err != nil {
+			return err
+		}
+	}
+	if *idet {
+		if err = v.DetectInterlacing(ctx, flag.Args()[0]); err != nil {
+			return err
+		}
+	}
 	t, err := template.New("").Parse(*format + "\n")
 	if err != nil {
 		return err
 	}
-	return t.Execute(os.Stdout, v)
+	if err = t.Execute(os.Stdout, v); err != nil || d == 0 {
+		return err
+	}
+	p, err := d.Plan(v)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\n%s\nffmpeg %s\n", p, strings.Join(p.Args(flag.Args()[0], "out."+p.Container), " "))
+	return nil
 }
 
 func main() {
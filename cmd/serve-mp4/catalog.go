@@ -5,6 +5,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -18,23 +20,36 @@ import (
 
 	"github.com/maruel/interrupt"
 	"github.com/maruel/serve-mp4/vid"
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
 	fsnotify "gopkg.in/fsnotify.v1"
 )
 
 // Entry is a single video file found.
 type Entry struct {
-	Rel           string // Relative path to source file.
-	preferredLang string // cache of prefered language.
-	rootDir       string // cache of root directory.
+	Rel            string        // Relative path to source file.
+	devices        []vid.Device  // cache of the devices to transcode for.
+	preferredLangs []string      // cache of prefered languages.
+	idet           bool          // cache of whether to detect interlacing.
+	probeTimeout   time.Duration // cache of the maximum duration of ffprobe.
+	rootDir        string        // cache of root directory.
 
 	// Mutable
 	mu          sync.Mutex
 	info        *vid.Info
-	err         error               // Cached error if Info() failed.
-	cached      map[vid.Device]bool // Transcoded paths.
-	transcoding bool                // transcoding
-	frame       int                 // frame at which transcoding is at
-	cold        bool                // cold means that the file disappeared in last refresh
+	err         error                     // Cached error if Info() failed.
+	cached      map[vid.Device]bool       // Transcoded paths.
+	containers  map[vid.Device]string     // Container of the transcoded files.
+	failed      map[vid.Device]string     // Reason the last transcoding failed.
+	hls         map[vid.Device]bool       // HLS stream has segments, even if still transcoding.
+	subtitles   map[vid.Device][]Subtitle // Extracted WebVTT files.
+	sidecars    []Subtitle                // Subtitle files next to the source file.
+	poster      bool                      // Poster thumbnail is in the cache.
+	sprite      bool                      // Seek preview sprite sheet is in the cache.
+	preview     bool                      // Animated preview is in the cache.
+	thumbnails  bool                      // Thumbnails generation was queued; it is only tried once.
+	transcoding bool                      // transcoding
+	progress    ffmpeg.Progress           // progress of the current transcoding
+	cold        bool                      // cold means that the file disappeared in last refresh
 }
 
 func (e *Entry) IsCached(v vid.Device) bool {
@@ -43,30 +58,113 @@ func (e *Entry) IsCached(v vid.Device) bool {
 	return e.cached[v]
 }
 
-func (e *Entry) IsCachedChromeCast() bool {
-	return e.IsCached(vid.ChromeCast)
+// Failure returns the reason the last transcoding for this device failed, if
+// any.
+func (e *Entry) Failure(v vid.Device) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.failed[v]
 }
 
-func (e *Entry) IsCachedChromeOS() bool {
-	return e.IsCached(vid.ChromeOS)
+// HLSURL returns the URL of the HLS playlist for the device, or "" if there is
+// none.
+//
+// It is available as soon as the first segments are transcoded.
+func (e *Entry) HLSURL(v vid.Device) string {
+	e.mu.Lock()
+	ok := e.hls[v]
+	e.mu.Unlock()
+	if !ok {
+		return ""
+	}
+	return "/" + urlName(v) + "/" + vid.HLSDir(e.Path(v)) + "/" + vid.HLSPlaylist
 }
 
+// Path is the path for the version transcoded for the device.
+//
+// It must be prepended by cacheDir and v.String().
 func (e *Entry) Path(v vid.Device) string {
-	return e.Rel[:len(e.Rel)-len(filepath.Ext(e.Rel))+1] + v.ToContainer()
+	return e.Rel[:len(e.Rel)-len(filepath.Ext(e.Rel))+1] + e.container(v)
 }
 
-// ChromeCastPath is the path for the ChromeCast version.
+// container returns the container of the version transcoded for the device.
 //
-// It must be prepended by cacheDir and v.String().
-func (e *Entry) ChromeCastPath() string {
-	return e.Path(vid.ChromeCast)
+// It depends on the source file; see vid.Device.Container.
+func (e *Entry) container(v vid.Device) string {
+	e.mu.Lock()
+	c, i := e.containers[v], e.info
+	e.mu.Unlock()
+	if c != "" {
+		return c
+	}
+	if i != nil {
+		return v.Container(i)
+	}
+	return v.ToContainer()
 }
 
-// ChromeOSPath is the path for the ChromeOS version.
+// Subtitles returns the WebVTT subtitles extracted along the transcoded
+// version for this device, followed by the sidecar subtitle files.
+func (e *Entry) Subtitles(v vid.Device) []Subtitle {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	out := make([]Subtitle, 0, len(e.subtitles[v])+len(e.sidecars))
+	return append(append(out, e.subtitles[v]...), e.sidecars...)
+}
+
+// PlayDevice returns the first device for which the entry is transcoded, or
+// 0 if none.
+func (e *Entry) PlayDevice() vid.Device {
+	for _, v := range e.devices {
+		if e.IsCached(v) {
+			return v
+		}
+	}
+	return 0
+}
+
+// PlayURL returns the URL to play this entry in a browser.
 //
-// It must be prepended by cacheDir and v.String().
-func (e *Entry) ChromeOSPath() string {
-	return e.Path(vid.ChromeOS)
+// The transcoded version is preferred since the source may not be playable.
+func (e *Entry) PlayURL() string {
+	if v := e.PlayDevice(); v != 0 {
+		return "/" + urlName(v) + "/" + e.Path(v)
+	}
+	return "/raw/" + e.Rel
+}
+
+// PosterURL returns the URL of the poster thumbnail, or "" if it wasn't
+// generated.
+func (e *Entry) PosterURL() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.poster {
+		return ""
+	}
+	return "/" + thumbnailPath(e.Rel, posterSuffix)
+}
+
+// SpriteURL returns the URL of the WebVTT thumbnail track for seek previews,
+// or "" if it wasn't generated.
+func (e *Entry) SpriteURL() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.sprite {
+		return ""
+	}
+	return "/" + thumbnailPath(e.Rel, spriteVTTSuffix)
+}
+
+// PreviewURL returns the URL of the animated preview, or "" if it wasn't
+// generated.
+func (e *Entry) PreviewURL() string {
+	e.mu.Lock()
+	ok := e.preview
+	e.mu.Unlock()
+	if !ok {
+		return ""
+	}
+	return "/preview/" + e.Path(vid.WEBPWebPreview)
 }
 
 func (e *Entry) IsTranscoding() bool {
@@ -76,19 +174,38 @@ func (e *Entry) IsTranscoding() bool {
 }
 
 // Percent returns the percentage at which transcoding is at.
+//
+// It is based on the duration, and falls back on the number of frames.
 func (e *Entry) Percent() string {
 	v := e.Info()
 	if v == nil {
 		return "N/A"
 	}
 	e.mu.Lock()
-	f := e.frame
+	p := e.progress
 	e.mu.Unlock()
-	nb, err := strconv.Atoi(v.Raw.Streams[v.VideoIndex].NbFrames)
-	if err != nil {
+	if v.Length > 0 {
+		return fmt.Sprintf("%3.1f%%", 100.*float64(p.OutTime)/float64(v.Length))
+	}
+	if v.NbFrames != 0 {
+		return fmt.Sprintf("%3.1f%%", 100.*float64(p.Frame)/float64(v.NbFrames))
+	}
+	return "N/A"
+}
+
+// ETA returns the estimated remaining time for the current transcoding.
+func (e *Entry) ETA() string {
+	v := e.Info()
+	if v == nil {
 		return "N/A"
 	}
-	return fmt.Sprintf("%3.1f%%", 100.*float32(f)/float32(nb))
+	e.mu.Lock()
+	p := e.progress
+	e.mu.Unlock()
+	if v.Length <= 0 || p.Speed <= 0 || p.OutTime > v.Length {
+		return "N/A"
+	}
+	return time.Duration(float64(v.Length-p.OutTime) / p.Speed).Round(time.Second).String()
 }
 
 // TryInfo returns vid.Info only if it had been loaded already.
@@ -99,13 +216,27 @@ func (e *Entry) TryInfo() *vid.Info {
 }
 
 // Info lazy loads e.info.
+//
+// ffprobe is aborted after probeTimeout so a damaged file doesn't hold the
+// lock forever.
 func (e *Entry) Info() *vid.Info {
 	s := e.srcFile()
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if e.info == nil && e.err == nil {
-		if e.info, e.err = vid.Identify(s, e.preferredLang); e.err != nil {
+		ctx := context.Background()
+		if e.probeTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, e.probeTimeout)
+			defer cancel()
+		}
+		if e.info, e.err = vid.Identify(ctx, s, e.preferredLangs); e.err != nil {
 			log.Printf("%q:%v", s, e.err)
+		} else if e.idet {
+			if err := e.info.DetectInterlacing(ctx, s); err != nil {
+				// Not fatal; the file is considered progressive.
+				log.Printf("%q:%v", s, err)
+			}
 		}
 	}
 	return e.info
@@ -117,10 +248,115 @@ func (e *Entry) srcFile() string {
 
 //
 
+// Subtitle is a WebVTT file in the cache.
+type Subtitle struct {
+	Lang string
+	Path string // Relative to the cache directory, using '/'.
+}
+
+// sidecarPrefix is the cache directory where sidecar subtitle files are
+// converted to WebVTT.
+const sidecarPrefix = "sidecar/"
+
+// sidecarPath returns the Subtitle.Path of the sidecar subtitle file name in
+// the directory rel, using '/'. It is converted on demand to this path in the
+// cache directory unless it is already a WebVTT file.
+func sidecarPath(rel, name string) string {
+	return sidecarPrefix + rel + name + ".vtt"
+}
+
+// sidecarLang returns the language of a sidecar subtitle file for a video.
+//
+// For "Movie.mkv", "Movie.fr.srt" is "fr" and "Movie.srt" is "und". Returns
+// "" if the sidecar doesn't belong to this video.
+func sidecarLang(video, sidecar string) string {
+	stem := video[:len(video)-len(filepath.Ext(video))]
+	sstem := sidecar[:len(sidecar)-len(filepath.Ext(sidecar))]
+	if sstem == stem {
+		return "und"
+	}
+	if !strings.HasPrefix(sstem, stem+".") {
+		return ""
+	}
+	// Ignore extra qualifiers like "Movie.fr.forced.srt".
+	lang := strings.SplitN(sstem[len(stem)+1:], ".", 2)[0]
+	if lang == "" {
+		return "und"
+	}
+	return strings.ToLower(lang)
+}
+
+// subtitleFromPath returns the Subtitle for a file named
+// "<base>.<index>.<lang>.vtt" relative to the cache directory.
+func subtitleFromPath(rel string) Subtitle {
+	parts := strings.Split(filepath.Base(rel), ".")
+	lang := "und"
+	if len(parts) >= 3 {
+		lang = parts[len(parts)-2]
+	}
+	return Subtitle{Lang: lang, Path: filepath.ToSlash(rel)}
+}
+
+// findSubtitles returns the WebVTT files extracted next to a transcoded file.
+//
+// p is the path of the transcoded file relative to cacheDir.
+func findSubtitles(cacheDir, p string) []Subtitle {
+	entries, err := os.ReadDir(filepath.Join(cacheDir, filepath.Dir(p)))
+	if err != nil {
+		return nil
+	}
+	prefix := filepath.Base(p)
+	prefix = prefix[:len(prefix)-len(filepath.Ext(prefix))+1]
+	var out []Subtitle
+	for _, f := range entries {
+		if n := f.Name(); strings.HasPrefix(n, prefix) && isExtractedSubtitle(n[len(prefix):]) {
+			out = append(out, subtitleFromPath(filepath.Join(filepath.Dir(p), n)))
+		}
+	}
+	return out
+}
+
+// isExtractedSubtitle returns true if the file name, without the stem of the
+// transcoded file, is "<index>.<lang>.vtt" as named by vid.ExtractSubtitles.
+//
+// Otherwise it may belong to another video whose name starts the same, e.g.
+// "bar.2.0.eng.vtt" belongs to "bar.2.mp4", not "bar.mp4".
+func isExtractedSubtitle(n string) bool {
+	parts := strings.Split(n, ".")
+	if len(parts) != 3 || parts[1] == "" || parts[2] != "vtt" {
+		return false
+	}
+	_, err := strconv.Atoi(parts[0])
+	return err == nil
+}
+
+// thumbnailsPrefix is the cache directory where the poster thumbnails and the
+// seek preview sprite sheets are stored.
+const thumbnailsPrefix = "thumbnails/"
+
+// Suffixes of the thumbnail files, replacing the extension of the source
+// file.
+const (
+	posterSuffix    = ".jpg"
+	spriteSuffix    = ".sprite.jpg"
+	spriteVTTSuffix = ".sprite.vtt"
+)
+
+// thumbnailPath returns the path of a thumbnail file relative to the cache
+// directory, using '/'.
+func thumbnailPath(rel, suffix string) string {
+	rel = filepath.ToSlash(rel)
+	return thumbnailsPrefix + rel[:len(rel)-len(filepath.Ext(rel))] + suffix
+}
+
+//
+
 // Directory is all files in a directory.
 type Directory struct {
 	Items   map[string]*Entry
 	Subdirs map[string]*Directory
+
+	sidecars map[string]bool // Subtitle files; the value is true when cold.
 }
 
 func (d *Directory) StillLoading() bool {
@@ -179,7 +415,10 @@ func (d *Directory) lookupEntry(rel string) *Entry {
 // findEntryToPreload is inefficient but for few thousands it should be fine.
 func (d *Directory) findEntryToPreload() *Entry {
 	for _, e := range d.Items {
-		if e.info == nil && e.err == nil {
+		e.mu.Lock()
+		todo := e.info == nil && e.err == nil
+		e.mu.Unlock()
+		if todo {
 			return e
 		}
 	}
@@ -191,59 +430,126 @@ func (d *Directory) findEntryToPreload() *Entry {
 	return nil
 }
 
+// appendEntries appends all the entries, recursively.
+func (d *Directory) appendEntries(out []*Entry) []*Entry {
+	for _, e := range d.Items {
+		out = append(out, e)
+	}
+	for _, s := range d.Subdirs {
+		out = s.appendEntries(out)
+	}
+	return out
+}
+
 // resetCold tags all entries as cold before reenumerating the directory.
 func (d *Directory) resetCold() {
 	for _, e := range d.Items {
 		e.cold = true
 	}
+	for name := range d.sidecars {
+		d.sidecars[name] = true
+	}
 	for _, s := range d.Subdirs {
 		s.resetCold()
 	}
 }
 
-// trimCold removes all entries tagged as cold.
-func (d *Directory) trimCold() {
+// trimCold removes all entries and sidecar subtitle files tagged as cold.
+//
+// Returns the removed entries appended to out and the Subtitle.Path of the
+// removed sidecar subtitle files appended to sidecars. rel is the relative
+// path of this directory, using '/'.
+func (d *Directory) trimCold(rel string, out []*Entry, sidecars []string) ([]*Entry, []string) {
 	for name, e := range d.Items {
 		if e.cold {
 			// File was deleted.
 			delete(d.Items, name)
+			out = append(out, e)
+		}
+	}
+	for name, cold := range d.sidecars {
+		if cold {
+			delete(d.sidecars, name)
+			sidecars = append(sidecars, sidecarPath(rel, name))
 		}
 	}
 	for name, s := range d.Subdirs {
-		s.trimCold()
-		if len(s.Items) == 0 && len(s.Subdirs) == 0 {
+		out, sidecars = s.trimCold(rel+name+"/", out, sidecars)
+		if len(s.Items) == 0 && len(s.Subdirs) == 0 && len(s.sidecars) == 0 {
 			delete(d.Subdirs, name)
 		}
 	}
+	return out, sidecars
+}
+
+// attachSidecars updates the sidecar subtitle files of each entry.
+//
+// rel is the relative path of this directory, using '/'.
+func (d *Directory) attachSidecars(rel string) {
+	for name, e := range d.Items {
+		var subs []Subtitle
+		for s := range d.sidecars {
+			if lang := sidecarLang(name, s); lang != "" {
+				subs = append(subs, Subtitle{Lang: lang, Path: sidecarPath(rel, s)})
+			}
+		}
+		sort.Slice(subs, func(i, j int) bool { return subs[i].Path < subs[j].Path })
+		e.mu.Lock()
+		e.sidecars = subs
+		e.mu.Unlock()
+	}
+	for name, s := range d.Subdirs {
+		s.attachSidecars(rel + name + "/")
+	}
 }
 
 //
 
 type Catalog interface {
 	CacheDir() string
+	// Devices returns the devices to transcode for, in order of preference.
+	Devices() []vid.Device
 	LookupEntry(rel string) *Entry
 	LookupDir(rel string) *Directory
+	// LookupSubtitle returns the path of the file to serve for a
+	// Subtitle.Path, converting a sidecar subtitle file on demand.
+	LookupSubtitle(ctx context.Context, rel string) (string, error)
 }
 
 type catalog struct {
-	preferredLang string
-	rootDir       string
-	cacheDir      string
+	devices        []vid.Device
+	preferredLangs []string
+	idet           bool
+	probeTimeout   time.Duration
+	rootDir        string
+	cacheDir       string
 
 	// Mutable.
 	mu            sync.RWMutex
 	tree          Directory
 	updatingInfos bool
+	convertMu     sync.Mutex // Serializes sidecar subtitle conversions.
 }
 
-func NewCatalog(rootDir, cacheDir, preferredLang string) (Catalog, error) {
+// NewCatalog returns a Catalog of the video files under rootDir.
+//
+// devices are the devices to transcode for, each one with its own
+// directory in cacheDir. preferredLangs is the ordered list of preferred audio
+// languages. idet enables frame analysis to detect interlacing when the field
+// order is unknown. probeTimeout is the maximum duration of the analysis of
+// one file.
+func NewCatalog(rootDir, cacheDir string, devices []vid.Device, preferredLangs []string, idet bool, probeTimeout time.Duration) (Catalog, error) {
 	c := &catalog{
-		preferredLang: preferredLang,
-		rootDir:       rootDir,
-		cacheDir:      cacheDir,
+		devices:        devices,
+		preferredLangs: preferredLangs,
+		idet:           idet,
+		probeTimeout:   probeTimeout,
+		rootDir:        rootDir,
+		cacheDir:       cacheDir,
 		tree: Directory{
-			Items:   map[string]*Entry{},
-			Subdirs: map[string]*Directory{},
+			Items:    map[string]*Entry{},
+			Subdirs:  map[string]*Directory{},
+			sidecars: map[string]bool{},
 		},
 		updatingInfos: true,
 	}
@@ -259,6 +565,10 @@ func (c *catalog) CacheDir() string {
 	return c.cacheDir
 }
 
+func (c *catalog) Devices() []vid.Device {
+	return c.devices
+}
+
 func (c *catalog) LookupEntry(rel string) *Entry {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -287,12 +597,57 @@ func (c *catalog) LookupDir(rel string) *Directory {
 	return out
 }
 
+func (c *catalog) LookupSubtitle(ctx context.Context, rel string) (string, error) {
+	if !strings.HasPrefix(rel, sidecarPrefix) {
+		return filepath.Join(c.cacheDir, filepath.FromSlash(rel)), nil
+	}
+	srel := strings.TrimSuffix(rel[len(sidecarPrefix):], ".vtt")
+	dir, name := "", srel
+	if i := strings.LastIndexByte(srel, '/'); i != -1 {
+		dir, name = srel[:i], srel[i+1:]
+	}
+	c.mu.RLock()
+	d := c.tree.lookupDir(dir)
+	found := d != nil && d.sidecars != nil
+	if found {
+		_, found = d.sidecars[name]
+	}
+	c.mu.RUnlock()
+	if !found {
+		return "", fmt.Errorf("no sidecar %q", srel)
+	}
+	src := filepath.Join(c.rootDir, filepath.FromSlash(srel))
+	if filepath.Ext(src) == ".vtt" {
+		return src, nil
+	}
+	dst := filepath.Join(c.cacheDir, filepath.FromSlash(rel))
+	c.convertMu.Lock()
+	defer c.convertMu.Unlock()
+	si, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	if di, err := os.Stat(dst); err == nil && di.Size() > 0 && !di.ModTime().Before(si.ModTime()) {
+		return dst, nil
+	}
+	if err = vid.ConvertSubtitle(ctx, src, dst); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
 func (c *catalog) findEntryToPreload() *Entry {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	return c.tree.findEntryToPreload()
 }
 
+func (c *catalog) entries() []*Entry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.tree.appendEntries(nil)
+}
+
 // addFile is called when a file is enumerated.
 func (c *catalog) addFile(rel string) {
 	//log.Printf("addFile(%q)", rel)
@@ -315,34 +670,73 @@ func (c *catalog) addFile(rel string) {
 		}
 		if _, ok := d.Subdirs[parts[0]]; !ok {
 			d.Subdirs[parts[0]] = &Directory{
-				Items:   map[string]*Entry{},
-				Subdirs: map[string]*Directory{},
+				Items:    map[string]*Entry{},
+				Subdirs:  map[string]*Directory{},
+				sidecars: map[string]bool{},
 			}
 		}
 		d = d.Subdirs[parts[0]]
 		rest = parts[1]
 	}
 
+	if isSubtitleExt(filepath.Ext(base)) {
+		// Attached to its Entry in attachSidecars().
+		d.sidecars[base] = false
+		return
+	}
+
 	e := &Entry{
-		Rel:           rel,
-		preferredLang: c.preferredLang,
-		rootDir:       c.rootDir,
-		cached:        map[vid.Device]bool{},
+		Rel:            rel,
+		devices:        c.devices,
+		preferredLangs: c.preferredLangs,
+		idet:           c.idet,
+		probeTimeout:   c.probeTimeout,
+		rootDir:        c.rootDir,
+		cached:         map[vid.Device]bool{},
+		containers:     map[vid.Device]string{},
+		failed:         map[vid.Device]string{},
+		hls:            map[vid.Device]bool{},
+		subtitles:      map[vid.Device][]Subtitle{},
 	}
-	for _, v := range []vid.Device{vid.ChromeCast, vid.ChromeOS} {
-		// For now force transcoding so -movflags +faststart is guaranteed.
-		p := toCachedPath(rel, v)
-		if i, err := os.Stat(filepath.Join(c.cacheDir, p)); err == nil && i.Size() > 0 {
-			e.cached[v] = true
+	for _, v := range c.devices {
+		// For now force transcoding so -movflags +faststart is guaranteed. The
+		// container depends on the source, which is not analyzed yet.
+		for _, ct := range v.Containers() {
+			p := toCachedPath(rel, v, ct)
+			if i, err := os.Stat(filepath.Join(c.cacheDir, p)); err == nil && i.Size() > 0 {
+				e.cached[v] = true
+				e.containers[v] = ct
+				e.subtitles[v] = findSubtitles(c.cacheDir, p)
+				delete(e.failed, v)
+				break
+			} else if _, err := os.Stat(filepath.Join(c.cacheDir, p+vid.RejectedSuffix)); err == nil {
+				// The reason was lost with the previous process.
+				e.failed[v] = "output was rejected, see " + p + vid.RejectedSuffix
+			}
 		}
+		// Incomplete streams are deleted by recoverPartials(). Only MP4 files are
+		// streamed.
+		if n, done := vid.HLSStatus(filepath.Join(c.cacheDir, vid.HLSDir(toCachedPath(rel, v, "mp4")))); n != 0 && done {
+			e.hls[v] = true
+		}
+	}
+	if i, err := os.Stat(filepath.Join(c.cacheDir, filepath.FromSlash(thumbnailPath(rel, posterSuffix)))); err == nil && i.Size() > 0 {
+		e.poster = true
+	}
+	if i, err := os.Stat(filepath.Join(c.cacheDir, filepath.FromSlash(thumbnailPath(rel, spriteVTTSuffix)))); err == nil && i.Size() > 0 {
+		e.sprite = true
+	}
+	if i, err := os.Stat(filepath.Join(c.cacheDir, toCachedPath(rel, vid.WEBPWebPreview, vid.WEBPWebPreview.ToContainer()))); err == nil && i.Size() > 0 {
+		e.preview = true
 	}
 	d.Items[base] = e
 }
 
 // enumerateEntries enumerates or reenumerates the tree.
 //
-// Returns all directories enumerated.
-func (c *catalog) enumerateEntries() []string {
+// Returns all directories enumerated. On failure, e.g. the root directory is
+// unmounted, the entries not found are kept, since they may still exist.
+func (c *catalog) enumerateEntries() ([]string, error) {
 	// Keep a writer lock for the duration of the enumeration.
 	c.mu.Lock()
 	c.updatingInfos = true
@@ -364,28 +758,226 @@ func (c *catalog) enumerateEntries() []string {
 			dirs = append(dirs, rel)
 			return nil
 		}
-		if !isValidExt(filepath.Ext(path)) {
+		if ext := filepath.Ext(path); isSubtitleExt(ext) {
+			c.addFile(rel)
+			return nil
+		} else if !isValidExt(ext) {
 			return err
 		}
 		found++
 		c.addFile(rel)
 		return nil
 	})
+	log.Printf("Found %d files", found)
 	if err != nil {
-		log.Printf("Failed to enumerate files: %v", err)
+		// Do not prune the files generated for the entries not found; they take
+		// hours to generate again.
+		c.mu.Lock()
+		c.tree.attachSidecars("")
+		c.mu.Unlock()
+		return dirs, fmt.Errorf("failed to enumerate files: %v", err)
 	}
-	log.Printf("Found %d files", found)
 
 	c.mu.Lock()
-	c.tree.trimCold()
+	removed, sidecars := c.tree.trimCold("", nil, nil)
+	c.tree.attachSidecars("")
 	c.mu.Unlock()
-	return dirs
+	for _, e := range removed {
+		c.prune(e)
+	}
+	for _, p := range sidecars {
+		c.pruneFile(filepath.FromSlash(p))
+	}
+	return dirs, nil
+}
+
+// recoverPartials deletes the partial files left by interrupted
+// transcodings, e.g. a crash or a power cut, and returns the transcodings to
+// resume.
+//
+// It must be called after enumerateEntries and before any thumbnail is
+// generated, since it can't tell them apart from leftovers.
+func (c *catalog) recoverPartials() []*transcodingRequest {
+	var out []*transcodingRequest
+	err := filepath.Walk(c.cacheDir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.IsDir() && strings.HasSuffix(path, vid.HLSSuffix) {
+			c.recoverHLS(path, &out)
+			return filepath.SkipDir
+		}
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, vid.PartialSuffix) {
+			return err
+		}
+		rel := path[len(c.cacheDir)+1 : len(path)-len(vid.PartialSuffix)]
+		if err := os.Remove(path); err != nil {
+			log.Printf("Failed to delete %q: %v", path, err)
+			return nil
+		}
+		if r := c.lookupCached(rel); r != nil {
+			log.Printf("Resuming interrupted transcoding of %q for %s", r.e.Rel, r.v)
+			out = append(out, r)
+		} else {
+			log.Printf("Deleted partial file %q", rel)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to look for partial files: %v", err)
+	}
+	return out
+}
+
+// recoverHLS deletes the HLS stream in dir if its transcoding was
+// interrupted and appends the transcoding to resume to out.
+func (c *catalog) recoverHLS(dir string, out *[]*transcodingRequest) {
+	if _, done := vid.HLSStatus(dir); done {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.Printf("Failed to delete %q: %v", dir, err)
+		return
+	}
+	// The stream is named after the transcoded file, whose extension depends
+	// on the device.
+	stem := dir[len(c.cacheDir)+1 : len(dir)-len(vid.HLSSuffix)]
+	var r *transcodingRequest
+	for _, v := range c.devices {
+		for _, ct := range v.Containers() {
+			if r == nil {
+				r = c.lookupCached(stem + "." + ct)
+			}
+		}
+	}
+	if r != nil {
+		log.Printf("Resuming interrupted transcoding of %q for %s", r.e.Rel, r.v)
+		*out = append(*out, r)
+	} else {
+		log.Printf("Deleted partial HLS stream %q", dir)
+	}
+}
+
+// lookupCached returns the device and the entry of a transcoded file, or nil
+// if it is not one of a device to transcode for.
+//
+// rel is relative to the cache directory.
+func (c *catalog) lookupCached(rel string) *transcodingRequest {
+	for _, v := range c.devices {
+		if !strings.HasPrefix(rel, v.String()+string(filepath.Separator)) {
+			continue
+		}
+		for _, e := range c.entries() {
+			for _, ct := range v.Containers() {
+				if toCachedPath(e.Rel, v, ct) == rel {
+					return &transcodingRequest{v: v, e: e}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// prune deletes the files generated in the background, the converted
+// sidecar subtitle files and the HLS streams for an entry whose source file
+// disappeared.
+//
+// The transcoded files are kept, since they were explicitly requested.
+func (c *catalog) prune(e *Entry) {
+	for _, p := range generatedPaths(e.Rel) {
+		c.pruneFile(p)
+	}
+	e.mu.Lock()
+	sidecars := e.sidecars
+	e.mu.Unlock()
+	for _, s := range sidecars {
+		c.pruneFile(filepath.FromSlash(s.Path))
+	}
+	// The MP4 file remuxed from the stream is enough to play the video.
+	for _, v := range c.devices {
+		p := vid.HLSDir(toCachedPath(e.Rel, v, v.ToContainer()))
+		if _, err := os.Stat(filepath.Join(c.cacheDir, p)); err != nil {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(c.cacheDir, p)); err == nil {
+			log.Printf("Pruned %q", p)
+		} else {
+			log.Printf("Failed to prune %q: %v", p, err)
+		}
+	}
+}
+
+// pruneFile deletes the file p, relative to the cache directory, if present.
+func (c *catalog) pruneFile(p string) {
+	if err := os.Remove(filepath.Join(c.cacheDir, p)); err == nil {
+		log.Printf("Pruned %q", p)
+	} else if !os.IsNotExist(err) {
+		log.Printf("Failed to prune %q: %v", p, err)
+	}
 }
 
-func toCachedPath(rel string, v vid.Device) string {
+// sweep deletes the files generated in the background and the converted
+// sidecar subtitle files for source files that disappeared while the server
+// was not running.
+//
+// It must be called after enumerateEntries.
+func (c *catalog) sweep() {
+	keep := map[string]bool{}
+	for _, e := range c.entries() {
+		for _, p := range generatedPaths(e.Rel) {
+			keep[p] = true
+		}
+		e.mu.Lock()
+		for _, s := range e.sidecars {
+			keep[filepath.FromSlash(s.Path)] = true
+		}
+		e.mu.Unlock()
+	}
+	for _, dir := range []string{vid.WEBPWebPreview.String(), filepath.FromSlash(thumbnailsPrefix), filepath.FromSlash(sidecarPrefix)} {
+		root := filepath.Join(c.cacheDir, dir)
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			// The partial files are handled by recoverPartials; new ones may be
+			// written concurrently.
+			if err != nil || info.IsDir() || strings.HasSuffix(path, vid.PartialSuffix) {
+				return err
+			}
+			rel := path[len(c.cacheDir)+1:]
+			if keep[rel] {
+				return nil
+			}
+			if err := os.Remove(path); err == nil {
+				log.Printf("Pruned %q", rel)
+			} else {
+				log.Printf("Failed to prune %q: %v", rel, err)
+			}
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("Failed to sweep %q: %v", root, err)
+		}
+	}
+}
+
+// generatedPaths returns the paths of the files generated in the background
+// for the source file rel, relative to the cache directory.
+func generatedPaths(rel string) []string {
+	return []string{
+		toCachedPath(rel, vid.WEBPWebPreview, vid.WEBPWebPreview.ToContainer()),
+		filepath.FromSlash(thumbnailPath(rel, posterSuffix)),
+		filepath.FromSlash(thumbnailPath(rel, spriteSuffix)),
+		filepath.FromSlash(thumbnailPath(rel, spriteVTTSuffix)),
+	}
+}
+
+// urlName returns the path component of the URLs to serve and transcode the
+// files for the device, e.g. "chromecast".
+func urlName(v vid.Device) string {
+	return strings.ToLower(v.String())
+}
+
+// toCachedPath returns the path of the file transcoded from rel for the
+// device in the container, relative to the cache directory.
+func toCachedPath(rel string, v vid.Device, container string) string {
 	path := filepath.Join(v.String(), rel)
 	ext := filepath.Ext(path)
-	return path[:len(path)-len(ext)] + "." + v.ToContainer()
+	return path[:len(path)-len(ext)] + "." + container
 }
 
 //
@@ -397,6 +989,7 @@ type Crawler interface {
 
 type crawler struct {
 	c       *catalog
+	t       TranscodingQueue
 	watcher *fsnotify.Watcher
 	refresh chan bool
 
@@ -406,22 +999,39 @@ type crawler struct {
 	watchedDirs   []string // absolute directories
 }
 
-func NewCrawler(cat Catalog) (Crawler, error) {
+// NewCrawler returns a Crawler keeping the Catalog up to date.
+//
+// The transcodings interrupted by a crash are queued again in t, and the
+// files generated for source files deleted in the meantime are pruned. Once
+// the files are analyzed, their thumbnails are queued in t.
+func NewCrawler(cat Catalog, t TranscodingQueue) (Crawler, error) {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
 	}
 	c := &crawler{
 		c:             cat.(*catalog),
+		t:             t,
 		watcher:       watcher,
 		refresh:       make(chan bool, 1000),
 		updatingInfos: true,
 	}
 	// Do the first enumeration and starts a routine to update file metadata.
-	if err := c.enumerateEntries(); err != nil {
-		c.Close()
-		return nil, err
+	err = c.enumerateEntries()
+	if err != nil {
+		// Serve what was found; the next refresh will try again.
+		log.Printf("%v", err)
+	}
+	// The thumbnails are written to partial files too, so they must not be
+	// generated before the leftovers are deleted.
+	for _, r := range c.c.recoverPartials() {
+		t.Transcode(r.v, r.e)
 	}
+	if err == nil {
+		// Only a complete enumeration tells which files disappeared.
+		c.c.sweep()
+	}
+	c.preload()
 	go c.handleRefresh(c.refresh)
 	return c, nil
 }
@@ -495,7 +1105,9 @@ func (c *crawler) preloadInfos(stamp time.Time) {
 		if e == nil {
 			break
 		}
-		e.Info()
+		if e.Info() != nil {
+			c.t.Thumbnails(e)
+		}
 	}
 
 	// Done.
@@ -503,13 +1115,25 @@ func (c *crawler) preloadInfos(stamp time.Time) {
 	c.updatingInfos = false
 	c.mu.Unlock()
 	log.Printf("Done pre-processing")
+	// Also the entries that were analyzed on demand.
+	for _, e := range c.c.entries() {
+		c.t.Thumbnails(e)
+	}
+}
+
+// preload calls preloadInfos() as a separate asynchronous goroutine.
+func (c *crawler) preload() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	go c.preloadInfos(c.lastUpdate)
 }
 
 // enumerateEntries enumerates or reenumerates the tree.
 //
-// Calls preloadInfos() as a separate asynchronous goroutine.
+// preload() must be called afterward. On failure, the directories found are
+// still watched.
 func (c *crawler) enumerateEntries() error {
-	dirs := c.c.enumerateEntries()
+	dirs, err := c.c.enumerateEntries()
 	sort.Strings(dirs)
 	for i := range dirs {
 		dirs[i] = filepath.Join(c.c.rootDir, dirs[i])
@@ -519,7 +1143,8 @@ func (c *crawler) enumerateEntries() error {
 	defer c.mu.Unlock()
 	for i, d := range c.watchedDirs {
 		j := sort.SearchStrings(dirs, d)
-		if dirs[j] != d {
+		// On failure, the directory may just not have been reached.
+		if err == nil && dirs[j] != d {
 			c.watchedDirs[i] = ""
 			if err := c.watcher.Remove(d); err != nil {
 				log.Printf("Failed to unwatch %q: %v", d, err)
@@ -554,8 +1179,7 @@ func (c *crawler) enumerateEntries() error {
 	}
 	log.Printf("Watching %d new directories", new)
 	c.lastUpdate = time.Now()
-	go c.preloadInfos(c.lastUpdate)
-	return nil
+	return err
 }
 
 // handleRefresh handles the events from refresh that are triggered via
@@ -565,15 +1189,17 @@ func (c *crawler) handleRefresh(refresh <-chan bool) {
 		<-refresh
 		log.Printf("Will refresh in 10s")
 		delay := time.After(10 * time.Second)
-		for {
+		for loop := true; loop; {
 			select {
 			case <-refresh:
 			case <-delay:
+				loop = false
 			}
 		}
 		if err := c.enumerateEntries(); err != nil {
 			log.Printf("failed to refresh files: %v", err)
 		}
+		c.preload()
 	}
 }
 
@@ -582,6 +1208,10 @@ func (c *crawler) handleRefresh(refresh <-chan bool) {
 type TranscodingQueue interface {
 	io.Closer
 	Transcode(v vid.Device, e *Entry)
+	// Thumbnails queues the generation of the poster, the seek preview sprite
+	// sheet and the animated preview in a low priority lane, which yields to
+	// Transcode. It never blocks.
+	Thumbnails(e *Entry)
 }
 
 type transcodingRequest struct {
@@ -590,73 +1220,268 @@ type transcodingRequest struct {
 }
 
 type transcodingQueue struct {
-	c     *catalog
-	mu    sync.Mutex
-	queue chan *transcodingRequest
+	c       *catalog
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+	queue   chan *transcodingRequest
+	idle    *sync.Cond // Signaled when pending drops to 0, when low grows and on Close.
+	pending int        // Number of transcodings queued or running; guarded by idle.L.
+	low     []*Entry   // Thumbnails to generate; guarded by idle.L.
+	lowMu   sync.Mutex
+	hls     bool // Stream MP4 transcodings with HLS while they run.
 }
 
-func NewTranscodingQueue(c Catalog) TranscodingQueue {
+// NewTranscodingQueue returns a queue transcoding one video at a time.
+//
+// With hls, the videos transcoded to MP4 can be played while they are being
+// transcoded.
+func NewTranscodingQueue(c Catalog, hls bool) TranscodingQueue {
+	ctx, cancel := context.WithCancel(context.Background())
 	t := &transcodingQueue{
-		c:     c.(*catalog),
-		queue: make(chan *transcodingRequest, 10240),
+		c:      c.(*catalog),
+		hls:    hls,
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan *transcodingRequest, 10240),
+		idle:   sync.NewCond(&sync.Mutex{}),
 	}
 	go t.run()
+	go t.runLow()
 	return t
 }
 
 func (t *transcodingQueue) Close() error {
-	// Flush the pending items in the transcoding queue, wait for the current
-	// transcoding to complete, return.
+	// Flush the pending items in the transcoding queue, kill the current
+	// transcoding, wait for it to clean up, return.
 	log.Printf("shutting down")
 	for stop := false; !stop; {
 		select {
-		case <-t.queue:
+		case r := <-t.queue:
+			if r != nil {
+				r.e.mu.Lock()
+				r.e.transcoding = false
+				r.e.mu.Unlock()
+				t.addPending(-1)
+			}
 		default:
 			t.queue <- nil
 			stop = true
 		}
 	}
+	t.cancel()
+	// Wake up runLow() so it notices the cancellation.
+	t.idle.L.Lock()
+	t.low = nil
+	t.idle.Broadcast()
+	t.idle.L.Unlock()
 	t.mu.Lock()
 	//lint:ignore SA2001 I forget why
 	t.mu.Unlock()
+	t.lowMu.Lock()
+	//lint:ignore SA2001 Same as above.
+	t.lowMu.Unlock()
 	return nil
 }
 
 func (t *transcodingQueue) Transcode(v vid.Device, e *Entry) {
 	e.mu.Lock()
 	e.transcoding = true
+	e.progress = ffmpeg.Progress{}
+	delete(e.failed, v)
 	e.mu.Unlock()
+	t.addPending(1)
 	t.queue <- &transcodingRequest{v: v, e: e}
 }
 
+func (t *transcodingQueue) Thumbnails(e *Entry) {
+	e.mu.Lock()
+	skip := e.thumbnails || (e.poster && e.sprite && e.preview)
+	e.thumbnails = true
+	e.mu.Unlock()
+	if !skip {
+		t.idle.L.Lock()
+		t.low = append(t.low, e)
+		t.idle.Broadcast()
+		t.idle.L.Unlock()
+	}
+}
+
 func (t *transcodingQueue) run() {
 	for r := range t.queue {
 		if r == nil {
 			break
 		}
-		p := func(frame int) {
+		i := r.e.Info()
+		if i == nil {
+			log.Printf("Skipping transcoding for %q", r.e.Rel)
 			r.e.mu.Lock()
-			r.e.frame = frame
+			r.e.transcoding = false
+			r.e.mu.Unlock()
+			t.addPending(-1)
+			continue
+		}
+		container := r.v.Container(i)
+		path := filepath.Join(t.c.cacheDir, toCachedPath(r.e.Rel, r.v, container))
+		hls := t.hls && container == "mp4"
+		live := false
+		p := func(p ffmpeg.Progress) {
+			// Offer the stream as soon as the first segment is written.
+			if hls && !live {
+				n, _ := vid.HLSStatus(vid.HLSDir(path))
+				live = n != 0
+			}
+			r.e.mu.Lock()
+			r.e.progress = p
+			if live {
+				r.e.hls[r.v] = true
+			}
 			r.e.mu.Unlock()
 		}
 
-		// Keeps the transcoding lock for the whole process so the serve-mp4
-		// executable doesn't abruptly interrupt the transcoding but do not keep
+		// Keeps the transcoding lock for the whole process so Close() can wait
+		// for ffmpeg to be killed and the partial file deleted but do not keep
 		// the Entry lock.
-		i := r.e.Info()
-		if i == nil {
-			log.Printf("Skipping transcoding for %q", r.e.Rel)
-		}
-		path := filepath.Join(t.c.cacheDir, toCachedPath(r.e.Rel, r.v))
+		var err error
 		t.mu.Lock()
-		err := r.v.Transcode(r.e.srcFile(), path, i, p)
+		// The previous stream, if any, is stale even when not streaming this
+		// time.
+		r.e.mu.Lock()
+		delete(r.e.hls, r.v)
+		r.e.mu.Unlock()
+		if err = os.RemoveAll(vid.HLSDir(path)); err != nil {
+			log.Printf("Failed to delete the HLS stream of %q: %v", r.e.Rel, err)
+		}
+		if hls {
+			err = r.v.TranscodeHLS(t.ctx, r.e.srcFile(), path, i, p)
+		} else {
+			err = r.v.Transcode(t.ctx, r.e.srcFile(), path, i, p)
+		}
+		var subs []Subtitle
+		if err == nil {
+			// Subtitles are best effort; they do not block playback.
+			paths, err2 := vid.ExtractSubtitles(t.ctx, r.e.srcFile(), path[:len(path)-len(filepath.Ext(path))], i)
+			if err2 != nil {
+				log.Printf("Failed to extract subtitles for %q: %v", r.e.Rel, err2)
+			}
+			for _, s := range paths {
+				subs = append(subs, subtitleFromPath(s[len(t.c.cacheDir)+1:]))
+			}
+		}
 		t.mu.Unlock()
 
+		reason := ""
+		if err == nil {
+			// A previous attempt is obsolete, including in another container.
+			for _, ct := range r.v.Containers() {
+				old := filepath.Join(t.c.cacheDir, toCachedPath(r.e.Rel, r.v, ct))
+				if ct != container {
+					os.Remove(old)
+				}
+				os.Remove(old + vid.RejectedSuffix)
+			}
+		} else if t.ctx.Err() == nil {
+			var verr *vid.VerifyError
+			if errors.As(err, &verr) {
+				reason = strings.Join(verr.Reasons, "; ")
+			} else {
+				reason = err.Error()
+			}
+			log.Printf("Transcoding %q for %s failed: %s", r.e.Rel, r.v, reason)
+		}
 		r.e.mu.Lock()
 		r.e.transcoding = false
 		if err == nil {
 			r.e.cached[r.v] = true
+			r.e.containers[r.v] = container
+			r.e.subtitles[r.v] = subs
+			// The progress may have been too short to notice the stream.
+			if hls {
+				r.e.hls[r.v] = true
+			}
+		} else {
+			// The stream was deleted.
+			delete(r.e.hls, r.v)
+			if reason != "" {
+				r.e.failed[r.v] = reason
+			}
 		}
 		r.e.mu.Unlock()
+		t.addPending(-1)
+	}
+}
+
+// addPending updates the number of transcodings queued or running and wakes
+// up runLow() when there is none left.
+func (t *transcodingQueue) addPending(delta int) {
+	t.idle.L.Lock()
+	t.pending += delta
+	if t.pending == 0 {
+		t.idle.Broadcast()
 	}
+	t.idle.L.Unlock()
+}
+
+// runLow generates the thumbnails when no transcoding is pending, so they
+// never delay what the user asked for.
+func (t *transcodingQueue) runLow() {
+	for {
+		t.idle.L.Lock()
+		for (len(t.low) == 0 || t.pending != 0) && t.ctx.Err() == nil {
+			t.idle.Wait()
+		}
+		if t.ctx.Err() != nil {
+			t.idle.L.Unlock()
+			return
+		}
+		e := t.low[0]
+		t.low[0] = nil
+		t.low = t.low[1:]
+		t.idle.L.Unlock()
+		t.lowMu.Lock()
+		if t.ctx.Err() == nil {
+			t.thumbnails(e)
+		}
+		t.lowMu.Unlock()
+	}
+}
+
+// thumbnails generates the poster, the sprite sheet and the animated preview
+// of an Entry.
+func (t *transcodingQueue) thumbnails(e *Entry) {
+	i := e.Info()
+	if i == nil {
+		return
+	}
+	e.mu.Lock()
+	poster, sprite, preview := e.poster, e.sprite, e.preview
+	e.mu.Unlock()
+	base := filepath.Join(t.c.cacheDir, filepath.FromSlash(thumbnailPath(e.Rel, "")))
+	if !poster {
+		if err := vid.Poster(t.ctx, e.srcFile(), base+posterSuffix, i); err != nil {
+			log.Printf("Failed to generate the poster for %q: %v", e.Rel, err)
+		} else {
+			poster = true
+		}
+	}
+	if !sprite {
+		if err := vid.Sprite(t.ctx, e.srcFile(), base+spriteSuffix, base+spriteVTTSuffix, i); err != nil {
+			log.Printf("Failed to generate the sprite sheet for %q: %v", e.Rel, err)
+		} else {
+			sprite = true
+		}
+	}
+	if !preview {
+		p := filepath.Join(t.c.cacheDir, toCachedPath(e.Rel, vid.WEBPWebPreview, vid.WEBPWebPreview.ToContainer()))
+		if err := vid.WEBPWebPreview.Transcode(t.ctx, e.srcFile(), p, i, nil); err != nil {
+			log.Printf("Failed to generate the preview for %q: %v", e.Rel, err)
+		} else {
+			preview = true
+		}
+	}
+	e.mu.Lock()
+	e.poster = poster
+	e.sprite = sprite
+	e.preview = preview
+	e.mu.Unlock()
 }
@@ -5,8 +5,17 @@
 package main
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid"
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
 )
 
 func TestCatalog(t *testing.T) {
@@ -68,7 +77,7 @@ func TestCatalog(t *testing.T) {
 func TestCatalog_addFile(t *testing.T) {
 	d, f := tmpDir(t)
 	defer f()
-	cat, err := NewCatalog(d, d, "")
+	cat, err := NewCatalog(d, d, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,3 +87,589 @@ func TestCatalog_addFile(t *testing.T) {
 		t.Fatalf("expected foo/bar.mp4; %#v", c.tree)
 	}
 }
+
+func TestCatalog_addFile_container(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	cat, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast, vid.ChromeOS}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	// The container depends on the source, so all of the device's are found.
+	p := filepath.Join(cache, "ChromeOS", "foo", "bar.webm")
+	if err = os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(p, []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c.addFile("foo/bar.avi")
+	e := c.LookupEntry("foo/bar.avi")
+	if !e.IsCached(vid.ChromeOS) || e.IsCached(vid.ChromeCast) {
+		t.Fatal("expected foo/bar.avi to be cached for ChromeOS only")
+	}
+	if u := e.PlayURL(); u != "/chromeos/foo/bar.webm" {
+		t.Fatalf("unexpected URL %q", u)
+	}
+	if r := c.lookupCached(filepath.Join("ChromeOS", "foo", "bar.webm")); r == nil || r.e != e || r.v != vid.ChromeOS {
+		t.Fatalf("unexpected request %v", r)
+	}
+}
+
+func TestCatalog_addFile_subtitles(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cat, err := NewCatalog(d, d, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	p := filepath.Join(d, "ChromeCast", "foo")
+	if err = os.MkdirAll(p, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	// bar.2.mp4's subtitles start with "bar.".
+	for _, n := range []string{"bar.mp4", "bar.2.fre.vtt", "bar.3.eng.vtt", "barbar.2.fre.vtt", "bar.2.mp4", "bar.2.0.eng.vtt"} {
+		if err = os.WriteFile(filepath.Join(p, n), []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.addFile("foo/bar.mkv")
+	e := c.LookupEntry("foo/bar.mkv")
+	if !e.IsCached(vid.ChromeCast) {
+		t.Fatal("expected cached")
+	}
+	expected := []Subtitle{
+		{Lang: "fre", Path: "ChromeCast/foo/bar.2.fre.vtt"},
+		{Lang: "eng", Path: "ChromeCast/foo/bar.3.eng.vtt"},
+	}
+	if s := e.Subtitles(vid.ChromeCast); !reflect.DeepEqual(s, expected) {
+		t.Fatalf("unexpected subtitles %#v", s)
+	}
+	c.addFile("foo/bar.2.mkv")
+	expected = []Subtitle{{Lang: "eng", Path: "ChromeCast/foo/bar.2.0.eng.vtt"}}
+	if s := c.LookupEntry("foo/bar.2.mkv").Subtitles(vid.ChromeCast); !reflect.DeepEqual(s, expected) {
+		t.Fatalf("unexpected subtitles %#v", s)
+	}
+}
+
+func TestCatalog_addFile_thumbnails(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cat, err := NewCatalog(d, d, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	p := filepath.Join(d, "thumbnails", "foo")
+	if err = os.MkdirAll(p, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(p, "bar.jpg"), []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c.addFile("foo/bar.mkv")
+	e := c.LookupEntry("foo/bar.mkv")
+	if u := e.PosterURL(); u != "/thumbnails/foo/bar.jpg" {
+		t.Fatalf("unexpected poster %q", u)
+	}
+	if u := e.SpriteURL(); u != "" {
+		t.Fatalf("unexpected sprite %q", u)
+	}
+}
+
+func TestSidecarLang(t *testing.T) {
+	data := []struct {
+		video, sidecar, lang string
+	}{
+		{"Movie.mkv", "Movie.fr.srt", "fr"},
+		{"Movie.mkv", "Movie.ENG.ass", "eng"},
+		{"Movie.mkv", "Movie.srt", "und"},
+		{"Movie.mkv", "Movie.fr.forced.srt", "fr"},
+		{"Movie.mkv", "Movie 2.fr.srt", ""},
+		{"Movie.mkv", "Movies.srt", ""},
+	}
+	for i, l := range data {
+		if lang := sidecarLang(l.video, l.sidecar); lang != l.lang {
+			t.Fatalf("#%d: sidecarLang(%q, %q) = %q; expected %q", i, l.video, l.sidecar, lang, l.lang)
+		}
+	}
+}
+
+func TestCatalog_sidecars(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cat, err := NewCatalog(d, filepath.Join(d, ".cache"), []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	if err = os.Mkdir(filepath.Join(d, "a"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []string{"Movie.fr.vtt", "Movie.mkv", "Movie.srt", "Other.en.srt"} {
+		if err = os.WriteFile(filepath.Join(d, "a", n), []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.enumerateEntries()
+	e := c.LookupEntry("a/Movie.mkv")
+	if e == nil {
+		t.Fatal("expected a/Movie.mkv")
+	}
+	expected := []Subtitle{
+		{Lang: "fr", Path: "sidecar/a/Movie.fr.vtt.vtt"},
+		{Lang: "und", Path: "sidecar/a/Movie.srt.vtt"},
+	}
+	if s := e.Subtitles(vid.ChromeCast); !reflect.DeepEqual(s, expected) {
+		t.Fatalf("unexpected subtitles %#v", s)
+	}
+	if p, err := c.LookupSubtitle(context.Background(), "sidecar/a/Movie.fr.vtt.vtt"); err != nil || p != filepath.Join(d, "a", "Movie.fr.vtt") {
+		t.Fatalf("LookupSubtitle() = %q, %v", p, err)
+	}
+	if _, err = c.LookupSubtitle(context.Background(), "sidecar/a/Unknown.srt.vtt"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Removing the sidecar is picked up on the next enumeration and its
+	// converted file is deleted.
+	converted := filepath.Join(d, ".cache", "sidecar", "a", "Movie.srt.vtt")
+	if err = os.MkdirAll(filepath.Dir(converted), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(converted, []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(d, "a", "Movie.srt")); err != nil {
+		t.Fatal(err)
+	}
+	c.enumerateEntries()
+	if s := e.Subtitles(vid.ChromeCast); !reflect.DeepEqual(s, expected[:1]) {
+		t.Fatalf("unexpected subtitles %#v", s)
+	}
+	if _, err = os.Stat(converted); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be pruned: %v", converted, err)
+	}
+}
+
+func TestCatalog_prune(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	cat, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	src := filepath.Join(d, "a", "Movie.mkv")
+	sidecar := filepath.Join(d, "a", "Movie.en.srt")
+	generated := []string{
+		filepath.Join(cache, "WEBPWebPreview", "a", "Movie.webp"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.jpg"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.sprite.jpg"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.sprite.vtt"),
+		filepath.Join(cache, "ChromeCast", "a", "Movie.hls", "index.m3u8"),
+		filepath.Join(cache, "sidecar", "a", "Movie.en.srt.vtt"),
+	}
+	transcoded := filepath.Join(cache, "ChromeCast", "a", "Movie.mp4")
+	for _, p := range append([]string{src, sidecar, transcoded}, generated...) {
+		if err = os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(p, []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.enumerateEntries()
+	e := c.LookupEntry("a/Movie.mkv")
+	if e == nil {
+		t.Fatal("expected a/Movie.mkv")
+	}
+	if u := e.PreviewURL(); u != "/preview/a/Movie.webp" {
+		t.Fatalf("unexpected preview %q", u)
+	}
+
+	// The files generated in the background are deleted along the source.
+	if err = os.Remove(src); err != nil {
+		t.Fatal(err)
+	}
+	c.enumerateEntries()
+	if c.LookupEntry("a/Movie.mkv") != nil {
+		t.Fatal("unexpected a/Movie.mkv")
+	}
+	for _, p := range generated {
+		if _, err = os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be pruned: %v", p, err)
+		}
+	}
+	if _, err = os.Stat(transcoded); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCatalog_prune_unmounted(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	root := filepath.Join(d, "root")
+	cache := filepath.Join(d, ".cache")
+	cat, err := NewCatalog(root, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	generated := []string{
+		filepath.Join(root, "a", "Movie.mkv"),
+		filepath.Join(cache, "WEBPWebPreview", "a", "Movie.webp"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.jpg"),
+	}
+	for _, p := range generated {
+		if err = os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(p, []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = c.enumerateEntries(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The root directory is unmounted; nothing is pruned.
+	if err = os.Rename(root, root+".old"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.enumerateEntries(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if c.LookupEntry("a/Movie.mkv") == nil {
+		t.Fatal("expected a/Movie.mkv")
+	}
+	for _, p := range generated[1:] {
+		if _, err = os.Stat(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCatalog_sweep(t *testing.T) {
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	cat, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	kept := []string{
+		filepath.Join(d, "a", "Movie.mkv"),
+		filepath.Join(cache, "WEBPWebPreview", "a", "Movie.webp"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.jpg"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.sprite.jpg"),
+		filepath.Join(cache, "thumbnails", "a", "Movie.sprite.vtt"),
+		filepath.Join(d, "a", "Movie.en.srt"),
+		filepath.Join(cache, "sidecar", "a", "Movie.en.srt.vtt"),
+		// Being written.
+		filepath.Join(cache, "thumbnails", "a", "Movie.jpg.partial"),
+		// The transcoded files are kept.
+		filepath.Join(cache, "ChromeCast", "a", "Gone.mp4"),
+	}
+	// The source was deleted while the server was not running.
+	pruned := []string{
+		filepath.Join(cache, "WEBPWebPreview", "a", "Gone.webp"),
+		filepath.Join(cache, "thumbnails", "a", "Gone.jpg"),
+		filepath.Join(cache, "thumbnails", "a", "Gone.sprite.jpg"),
+		filepath.Join(cache, "thumbnails", "b", "Gone.sprite.vtt"),
+		filepath.Join(cache, "sidecar", "a", "Gone.en.srt.vtt"),
+	}
+	for _, p := range append(kept, pruned...) {
+		if err = os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(p, []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.enumerateEntries()
+	c.sweep()
+	for _, p := range kept {
+		if _, err = os.Stat(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range pruned {
+		if _, err = os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be pruned: %v", p, err)
+		}
+	}
+}
+
+func TestCrawler_recoverPartials(t *testing.T) {
+	fake := setFakeFFmpeg(t)
+	fake.Probes["c.mp4"] = fake.Probes["b.mp4"]
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	files := []string{
+		filepath.Join(d, "a", "b.mp4"),
+		filepath.Join(d, "a", "c.mp4"),
+		// Interrupted transcoding, resumed.
+		filepath.Join(cache, "ChromeCast", "a", "b.mp4.partial"),
+		// The source is gone, deleted.
+		filepath.Join(cache, "ChromeCast", "a", "gone.mp4.partial"),
+		// Interrupted HLS stream, resumed.
+		filepath.Join(cache, "ChromeCast", "a", "c.hls", "index.m3u8"),
+	}
+	for _, p := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tq := NewTranscodingQueue(c, false)
+	defer tq.Close()
+	crawl, err := NewCrawler(c, tq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer crawl.Close()
+	for _, p := range files[2:] {
+		if _, err = os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be deleted: %v", p, err)
+		}
+	}
+	for _, n := range []string{"b", "c"} {
+		e := c.LookupEntry("a/" + n + ".mp4")
+		waitFor(t, "the transcoding of "+n, func() bool { return e.IsCached(vid.ChromeCast) })
+		dst := filepath.Join(cache, "ChromeCast", "a", n+".mp4")
+		if _, err = os.Stat(dst); err != nil {
+			t.Fatal(err)
+		}
+		resumed := false
+		for _, r := range fake.Runs() {
+			resumed = resumed || r[len(r)-1] == dst+vid.PartialSuffix
+		}
+		if !resumed {
+			t.Fatalf("expected the transcoding of %s to be resumed, got %q", n, fake.Runs())
+		}
+	}
+}
+
+func TestCrawler_recoverPartials_thumbnails(t *testing.T) {
+	exe := &slowThumbnails{Fake: setFakeFFmpeg(t), release: make(chan struct{})}
+	ffmpeg.Default = exe
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	files := []string{
+		filepath.Join(d, "a", "b.mp4"),
+		// Leftover of a crash, deleted.
+		filepath.Join(cache, "thumbnails", "a", "gone.jpg.partial"),
+	}
+	for _, p := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("a"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tq := NewTranscodingQueue(c, false)
+	defer tq.Close()
+	crawl, err := NewCrawler(c, tq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer crawl.Close()
+	close(exe.release)
+	if _, err = os.Stat(files[1]); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be deleted: %v", files[1], err)
+	}
+	// The thumbnails being written at startup are kept.
+	e := c.LookupEntry("a/b.mp4")
+	waitFor(t, "the thumbnails", func() bool {
+		return e.PosterURL() != "" && e.SpriteURL() != "" && e.PreviewURL() != ""
+	})
+}
+
+// slowThumbnails is an ffmpeg.Executor starting to write the thumbnails
+// like ffmpeg, then waiting for release. It fails if the partial file was
+// deleted in the meantime.
+type slowThumbnails struct {
+	*ffmpegtest.Fake
+	release chan struct{}
+}
+
+func (s *slowThumbnails) Run(ctx context.Context, args []string) ([]byte, error) {
+	if dst := args[len(args)-1]; strings.HasSuffix(dst, vid.PartialSuffix) {
+		if err := os.WriteFile(dst, nil, 0o600); err != nil {
+			return nil, err
+		}
+		<-s.release
+		if _, err := os.Stat(dst); err != nil {
+			return nil, err
+		}
+	}
+	return s.Fake.Run(ctx, args)
+}
+
+func TestTranscodingQueue_rejected(t *testing.T) {
+	fake := setFakeFFmpeg(t)
+	// The output is still MPEG-4 part 2.
+	fake.Probes["b.mp4.partial"] = fake.Probes["b.mp4"]
+	d, f := tmpDir(t)
+	defer f()
+	cache := filepath.Join(d, ".cache")
+	src := filepath.Join(d, "a", "b.mp4")
+	if err := os.MkdirAll(filepath.Dir(src), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(src, []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cat, err := NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	c.enumerateEntries()
+	e := c.LookupEntry("a/b.mp4")
+	tq := NewTranscodingQueue(c, false)
+	defer tq.Close()
+	tq.Transcode(vid.ChromeCast, e)
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
+	const want = "video is mpeg4, expected h264"
+	if e.IsCached(vid.ChromeCast) || e.Failure(vid.ChromeCast) != want {
+		t.Fatalf("unexpected failure %q", e.Failure(vid.ChromeCast))
+	}
+	rejected := filepath.Join(cache, "ChromeCast", "a", "b.mp4"+vid.RejectedSuffix)
+	if _, err = os.Stat(rejected); err != nil {
+		t.Fatal(err)
+	}
+
+	// The failure is remembered across restarts.
+	if cat, err = NewCatalog(d, cache, []vid.Device{vid.ChromeCast}, nil, false, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	c = cat.(*catalog)
+	c.enumerateEntries()
+	e = c.LookupEntry("a/b.mp4")
+	if e.IsCached(vid.ChromeCast) || !strings.Contains(e.Failure(vid.ChromeCast), "rejected") {
+		t.Fatalf("unexpected failure %q", e.Failure(vid.ChromeCast))
+	}
+}
+
+func TestTranscodingQueue_hls(t *testing.T) {
+	// A short video is transcoded before any progress is reported.
+	ffmpeg.Default = &noProgress{setFakeFFmpeg(t)}
+	d, f := tmpDir(t)
+	defer f()
+	src := filepath.Join(d, "a", "b.mp4")
+	if err := os.MkdirAll(filepath.Dir(src), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(src, []byte("a"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cat, err := NewCatalog(d, filepath.Join(d, ".cache"), []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := cat.(*catalog)
+	c.enumerateEntries()
+	e := c.LookupEntry("a/b.mp4")
+	tq := NewTranscodingQueue(c, true)
+	defer tq.Close()
+	tq.Transcode(vid.ChromeCast, e)
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
+	if !e.IsCached(vid.ChromeCast) {
+		t.Fatalf("unexpected failure %q", e.Failure(vid.ChromeCast))
+	}
+	if u := e.HLSURL(vid.ChromeCast); u != "/chromecast/a/b.hls/index.m3u8" {
+		t.Fatalf("unexpected HLS URL %q", u)
+	}
+}
+
+// noProgress is an ffmpeg.Executor never reporting progress.
+type noProgress struct {
+	*ffmpegtest.Fake
+}
+
+func (n *noProgress) Run(ctx context.Context, args []string) ([]byte, error) {
+	var out []string
+	for i := 0; i < len(args); i++ {
+		if args[i] == "-progress" {
+			i++
+			continue
+		}
+		out = append(out, args[i])
+	}
+	return n.Fake.Run(ctx, out)
+}
+
+func TestTranscodingQueue_Thumbnails(t *testing.T) {
+	setFakeFFmpeg(t)
+	d, f := tmpDir(t)
+	defer f()
+	c, err := NewCatalog(d, filepath.Join(d, ".cache"), []vid.Device{vid.ChromeCast}, nil, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tq := NewTranscodingQueue(c, false)
+	defer tq.Close()
+	// While a transcoding is pending, queuing the thumbnails of a large library
+	// doesn't block.
+	tq.(*transcodingQueue).addPending(1)
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 20000; i++ {
+			tq.Thumbnails(&Entry{})
+		}
+		close(done)
+	}()
+	waitFor(t, "the thumbnails to be queued", func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func TestEntry_Percent(t *testing.T) {
+	e := Entry{info: &vid.Info{Length: 100 * time.Second}}
+	if p := e.Percent(); p != "0.0%" {
+		t.Fatal(p)
+	}
+	if eta := e.ETA(); eta != "N/A" {
+		t.Fatal(eta)
+	}
+	e.progress = ffmpeg.Progress{Frame: 10, OutTime: 25 * time.Second, Speed: 2.5}
+	if p := e.Percent(); p != "25.0%" {
+		t.Fatal(p)
+	}
+	if eta := e.ETA(); eta != "30s" {
+		t.Fatal(eta)
+	}
+
+	// Fallback on the number of frames when the duration is unknown.
+	e.info = &vid.Info{NbFrames: 40}
+	if p := e.Percent(); p != "25.0%" {
+		t.Fatal(p)
+	}
+	if eta := e.ETA(); eta != "N/A" {
+		t.Fatal(eta)
+	}
+}
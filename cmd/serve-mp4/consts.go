@@ -39,37 +39,88 @@ form input {
 img {
 	height: 1em;
 }
+img.poster {
+	height: 3em;
+	vertical-align: middle;
+}
 .downloads {
 	display: inline;
 }
 </style>
+<script>
+// swapPreview toggles a poster with its animated preview.
+function swapPreview(img) {
+	const src = img.src;
+	img.src = img.dataset.preview;
+	img.dataset.preview = src;
+}
+</script>
 {{if .Rel}} - <a href="..">Parent</a><br>{{end}}
 {{- range $name, $e := .Directory.Subdirs}} - <a href="{{$name}}/">{{$name}}/</a> ({{$e.TotalItems}} files)<br>
 {{- end -}}
-{{- range $name, $e := .Directory.Items}} - {{$name}} – {{if $e.IsTranscoding -}}
-		{{$e.Percent}} <img src="/spinner.gif" />
+{{- range $name, $e := .Directory.Items}} - {{with $e.PosterURL}}<img class="poster" src="{{.}}" loading="lazy"
+	{{- with $e.PreviewURL}} data-preview="{{.}}" onmouseenter="swapPreview(this)" onmouseleave="swapPreview(this)"{{end}} /> {{end}}<a href="/entry/{{$e.Rel}}">{{$name}}</a> – {{if $e.IsTranscoding -}}
+		{{$e.Percent}} ETA {{$e.ETA}} <img src="/spinner.gif" />
 	{{- end -}}
 	<div class="downloads">
-		{{- if $e.IsCachedChromeCast -}}
-			<a href="/chromecast/{{$e.ChromeCastPath}}"><img src="/cast.svg" /></a>
-		{{- else -}}
-			<form action="/transcode/chromecast/{{$e.Rel}}" method="POST">
-				<input type="image" name="submit" alt="Submit" src="/cast.svg" />
-			</form>
-		{{- end -}}
-		&nbsp;
-		{{- if $e.IsCachedChromeOS -}}
-			<a href="/chromeos/{{$e.ChromeOSPath}}"><img src="/chromeos.svg" /></a>
-		{{- else -}}
-			<form action="/transcode/chromeos/{{$e.Rel}}" method="POST">
-				<input type="image" name="submit" alt="Submit" src="/chromeos.svg" />
-			</form>
-		{{end}}
-		&nbsp;
+		{{- range $v := $.Devices -}}
+			{{- if $e.IsCached $v -}}
+				<a href="/{{urlName $v}}/{{$e.Path $v}}"><img src="{{icon $v}}" title="{{$v}}" /></a>
+				{{- range $e.Subtitles $v}} <a href="/subtitles/{{.Path}}">{{.Lang}}</a>{{end}}
+			{{- else -}}
+				<form action="/transcode/{{urlName $v}}/{{$e.Rel}}" method="POST">
+					<input type="image" name="submit" alt="{{$v}}" title="{{$v}}" src="{{icon $v}}" />
+					{{- with $e.Failure $v}} <span title="{{.}}">failed</span>{{end}}
+				</form>
+			{{- end}}
+			{{- with $e.HLSURL $v}} <a href="{{.}}" title="HLS stream for {{$v}}">HLS</a>{{end}}
+			&nbsp;
+		{{- end}}
 		<a href="/raw/{{$e.Rel}}"><img src="/vlc.svg" style="height:1em" /></a>
 	</div>
 	 – <a href="/metadata/{{$e.Rel}}">{{if $e.TryInfo -}}{{$e.TryInfo.Duration}}{{else}}Meta{{end}}</a><br>
 {{- end}}
+`
+
+	entryRaw = `<!DOCTYPE html>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<link rel="shortcut icon" type="image/png" href="/favicon.ico"/>
+<style>
+img {
+	height: 1em;
+}
+img.poster {
+	height: auto;
+	max-width: 100%;
+}
+</style>
+<a href="/browse/{{.Dir}}">Parent</a><br>
+<h1>{{.Title}}</h1>
+{{- with .Entry.PosterURL}}
+<img class="poster" src="{{.}}" /><br>
+{{- end}}
+{{- with .Info}}
+Duration: {{.Duration}}<br>
+Video: {{.VideoCodec}} {{.Width}}x{{.Height}}@{{.FrameRate}}<br>
+Audio: {{.AudioCodec}} {{.AudioLang}}<br>
+{{- end}}
+<a href="{{.Entry.PlayURL}}">Play</a> – <a href="/metadata/{{.Entry.Rel}}">Metadata</a><br>
+{{- with .Entry.Subtitles .Entry.PlayDevice}}
+Subtitles:{{range .}} <a href="/subtitles/{{.Path}}">{{.Lang}}</a>{{end}}<br>
+{{- end}}
+{{- with .Entry.SpriteURL}}
+Seek previews: <a href="{{.}}">WebVTT</a><br>
+{{- end}}
+{{- with .Info}}{{if .Chapters}}
+<h2>Chapters</h2>
+<ol>
+{{- range .Chapters}}
+	<li><a href="{{$.Entry.PlayURL}}#t={{.Start.Seconds}}">{{if .Title}}{{.Title}}{{else}}{{.Start}}{{end}}</a></li>
+{{- end}}
+</ol>
+{{- end}}{{end}}
 `
 
 	//
@@ -324,6 +375,12 @@ mNQhKJJI9A0HifjVF26eWXYIYp5phklqlFAgA7`
 	<path fill="url(#yellowShadow)" d="M 228 78 L 127 78 A 50 50 0 0 1 166 97 L	230 81" id="path65" />
 </svg>`
 
+	// Icon for other devices; Material Design "tv".
+	screenIcon = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<svg width="24px" height="24px" viewBox="0 0 24 24" version="1.1" xmlns="http://www.w3.org/2000/svg">
+	<path d="M21,3 L3,3 C1.9,3 1,3.9 1,5 L1,17 C1,18.1 1.9,19 3,19 L8,19 L8,21 L16,21 L16,19 L21,19 C22.1,19 22.99,18.1 22.99,17 L23,5 C23,3.9 22.1,3 21,3 L21,3 Z M21,17 L3,17 L3,5 L21,5 L21,17 L21,17 Z" fill="#000000"></path>
+</svg>`
+
 	// Icon for VLC. I bit more complex:
 	vlcIcon = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="680" height="770"><defs><linearGradient id="i"><stop offset="0" stop-color="#e26400"/><stop offset="1" stop-color="#ffb900"/></linearGradient><linearGradient id="h"><stop offset="0" stop-color="#9b4400"/><stop offset="1" stop-color="#e56300"/></linearGradient><linearGradient id="g"><stop offset="0" stop-color="#f48200" stop-opacity="0"/><stop offset=".589" stop-color="#f48200" stop-opacity=".728"/><stop offset=".816" stop-color="#cc5f00"/><stop offset="1" stop-color="#a43d00"/></linearGradient><linearGradient id="f"><stop offset="0" stop-color="#a1abb2"/><stop offset=".083" stop-color="#eaeced"/><stop offset="1" stop-color="#c6ccd0"/></linearGradient><linearGradient id="e"><stop offset="0" stop-color="#7a8288"/><stop offset=".529" stop-color="#9fa3a7"/><stop offset="1" stop-color="#7d858b"/></linearGradient><linearGradient id="d"><stop offset="0" stop-color="#a1abb2"/><stop offset=".148" stop-color="#eaeced"/><stop offset="1" stop-color="#c6ccd0"/></linearGradient><linearGradient id="c"><stop offset="0" stop-color="#7a8288"/><stop offset=".076" stop-color="#9fa3a7"/><stop offset="1" stop-color="#7d858b"/></linearGradient><linearGradient id="b"><stop offset="0" stop-color="#ffb900"/><stop offset="1" stop-color="#ffd76b"/></linearGradient><linearGradient xlink:href="#a" id="r" gradientUnits="userSpaceOnUse" gradientTransform="translate(-181.875 -35.717)" spreadMethod="reflect" x1="236.651" y1="158.479" x2="236.052" y2="162.893"/><linearGradient id="a"><stop offset="0" stop-color="#f68000"/><stop offset="1" stop-color="#fd9800"/></linearGradient><filter color-interpolation-filters="sRGB" id="s"><feGaussianBlur stdDeviation="1.135"/></filter><linearGradient xlink:href="#b" id="q" gradientUnits="userSpaceOnUse" x1="-113.893" y1="461.921" x2="412.23" y2="463.262" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#c" id="p" gradientUnits="userSpaceOnUse" gradientTransform="translate(174.752 255.36) scale(1.23516)" x1="107.209" y1="-.642" x2="151.346" y2="12.703"/><linearGradient xlink:href="#d" id="o" gradientUnits="userSpaceOnUse" x1="104.886" y1="12.641" x2="164.759" y2="27.389" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#e" id="n" gradientUnits="userSpaceOnUse" x1="18.875" y1="252.484" x2="334.031" y2="268.415" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#f" id="m" gradientUnits="userSpaceOnUse" x1="44.104" y1="228.6" x2="278.432" y2="290.7" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#g" id="l" gradientUnits="userSpaceOnUse" x1="115.202" y1="70.105" x2="261.622" y2="55.356" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#h" id="k" gradientUnits="userSpaceOnUse" x1="64.751" y1="102.284" x2="93.522" y2="108.988" gradientTransform="translate(175.364 255.41) scale(1.23516)"/><linearGradient xlink:href="#i" id="j" gradientUnits="userSpaceOnUse" gradientTransform="matrix(4.37655 0 0 4.4651 175.365 249.18)" x1="40.775" y1="100.342" x2="44.376" y2="125.316"/></defs><path d="M18.405 714.967S7.6 726.622 4.742 736.083c-1.997 6.61-2.777 16.467.828 22.357 2.645 4.322 6.112 8.694 11.18 8.695 220.292.032 463.914.356 635.322.828 5.803.016 16.81-1.003 21.322-7.453 4.6-6.574 4.486-21.23 1.242-28.568-3.415-7.727-19.46-11.178-19.46-11.178l-636.77-5.797z" fill="#cb5600" fill-rule="evenodd"/><path d="M152.203 563.31c-10.065 0-20.514 7.754-23.43 17.41L58.447 813.674c-2.916 9.656 2.827 17.45 12.892 17.45h648.54c7.67 0 12.06-5.915 9.84-13.272l-72.88-241.27c-2.22-7.358-10.17-13.272-17.84-13.272H152.2z" fill="url(#j)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M392.52 83.376c-16.728-.168-26.57 1.075-36.436 8.492-4.504 3.385-8.063 11.425-10.19 17.215L174.207 675.828s4.003 25.61 28.29 48.89c16.374 15.694 47.426 27.32 66.933 31.55 50.068 10.85 90.217 13.442 135.624 12.754 46.64-.706 93.236-9.873 138.08-22.706 66.884-19.14 69.014-72.843 69.014-72.843L435.558 98.545c-.727-2.367-2.496-4.113-3.898-5.442-10.49-9.943-26.385-9.6-39.14-9.727z" fill="url(#k)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M392.52 83.376c-16.728-.168-26.57 1.075-36.436 8.492-4.504 3.385-8.063 11.425-10.19 17.215L174.207 675.828s7.55 49.018 68.308 72.91c42.057 16.54 85.467 18.734 153.017 19.456 75.227.805 105.872-6.576 155.042-23.733 23.146-8.07 35.144-18.57 46.12-30.21 15.875-16.83 15.454-40.77 15.454-40.77L435.558 98.55c-1.193-2.17-2.496-4.113-3.898-5.44-10.49-9.945-26.385-9.6-39.14-9.73z" fill="url(#l)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M236.89 468.94l-39.254 129.575c47.608 40.123 117.476 65.463 195.348 65.463 78.223 0 148.345-25.565 195.966-66.004l-39.525-128.727c-36.388 29.03-92.782 47.67-156.094 47.67-63.52 0-120.06-18.783-156.44-47.978z" fill="url(#m)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M237.547 470.56l-2.316 7.642c-.02 1.674-.06 3.347-.07 5.018-.5.053-.98.094-1.46.155l-2.04 6.793.74-.116c.84.807 1.69 1.6 2.55 2.393-.01 2.17-.03 4.353-.07 6.524-1.47.15-2.92.36-4.28.46 0 .06.01.13 0 .19l-1.39-1.32-.31.96 1.66 1.58c-.01 1.14.01 2.28-.04 3.39l-3.24.5-.42 1.39 4.86-.74c1 .92 2 1.83 3.01 2.74-.04 2.18-.03 4.36-.08 6.52-1.34.18-2.6.36-3.9.5l-5.17-4.83-.35 1.12 5.1 4.74c-.02 1.27-.07 2.55-.08 3.78l-5.09.73v-.04c-.69-.62-1.42-1.25-2.12-1.89l-3.32 10.96-.03 2.51c-.25.02-.49.02-.73.04l-2.05 6.83c.83.79 1.67 1.58 2.55 2.39-.08 2.2-.11 4.38-.16 6.56-1.43.1-2.97.16-4.44.31l-.04.93-.77-.77-.31 1.04 1.08 1.04c-.03.81-.07 1.64-.08 2.43l-2.12.27-.42 1.39 3.52-.47c1.1 1.02 2.19 2 3.28 3.01-.04 2.21-.03 4.4-.08 6.6-1.48.12-2.99.29-4.4.39 0 .09.01.18 0 .27l-4.09-3.94-.35 1.12 4.4 4.25c-.03.8-.1 1.62-.11 2.43l-6.56.85-2.01 6.67c.72-.06 1.44-.13 2.16-.19l6.06 5.79c-.02 1.18-.06 2.37-.11 3.51l-6.02.81c-1.02-.97-2-1.93-2.97-2.86.03-1.51.08-3.01.12-4.52l-3.44 11.39c.62.57 1.22 1.14 1.85 1.73-.06 2.21-.14 4.39-.23 6.6-1.47.14-2.84.32-4.28.46l-.19.69c3.04 2.54 6.16 5 9.38 7.41.06-1.43.11-2.85.23-4.24-.32-.31-.64-.62-.97-.93l6.18-.85v.12c1.37 1.33 2.77 2.68 4.21 4.01-.08 2.17-.09 4.3-.12 6.45-.92.09-1.87.2-2.82.3 2.59 1.8 5.23 3.6 7.92 5.32l.04-.89 5.64-1.12c1.25 1.11 2.54 2.22 3.79 3.32-.03 1.47-.07 2.94-.11 4.4.42.24.82.5 1.24.74.04-1.63.11-3.27.16-4.9 1.27-.18 2.48-.38 3.71-.62l5.44 4.71c-.04 1.34-.08 2.67-.08 3.97l-2.51.54c.59.32 1.17.65 1.77.97l1.82-.38c1.04.86 2.11 1.69 3.16 2.55-.01.14 0 .28 0 .42.42.21.85.4 1.27.62.01-.31-.01-.62 0-.93 1.04-.18 2.09-.33 3.13-.54l5.98 4.87v.93c3.12 1.42 6.29 2.8 9.5 4.13.02-.76.01-1.52.04-2.28l6.22-1.74c1.08.82 2.19 1.62 3.32 2.43-.04 1.78-.05 3.55-.04 5.33.42.16.85.31 1.27.46.03-1.92.04-3.85.08-5.75.93-.21 1.87-.4 2.78-.62l6.29 4.71c-.03 1.03-.07 2.05-.08 3.05l-2.82.81c.67.23 1.33.47 2 .69l.81-.23v.07c.37.27.74.53 1.12.81 1.97.64 3.95 1.28 5.94 1.89.66-.2 1.31-.38 1.97-.58l2.85 2.04c1.04.3 2.09.56 3.13.85l-5.48-3.86c.04-1.32.06-2.61.12-3.94-.13-.09-.22-.18-.35-.27l6.06-2.05c1.21.86 2.48 1.77 3.74 2.67-.04 2.13-.07 4.29-.08 6.44-1.21.39-2.45.76-3.67 1.12 3.62 1 7.31 1.93 11 2.82l4.63-1.7c.92.61 1.86 1.24 2.78 1.81-.01.53-.03 1.06-.04 1.59l1.24.27c.01-.58.04-1.16.04-1.73 1.16-.38 2.35-.8 3.59-1.24l5.68 3.62c-.02.38-.03.75-.04 1.12 3.15.57 6.34 1.13 9.53 1.62.03-.45.09-.9.12-1.35l6.06-2.62c1.08.62 2.2 1.28 3.32 1.93-.01 1.12.03 2.24.04 3.36l1.35.15c.02-1.17.03-2.33.04-3.51 1.08-.41 2.08-.84 3.09-1.27l6.14 3.47c-.04.78-.03 1.55-.04 2.32 3.18.29 6.36.52 9.57.73 0-.98.01-1.97.04-2.97l5.94-2.9c1.13.62 2.28 1.25 3.44 1.85 0 1.5-.03 3.01-.04 4.51l1.24.03c.01-1.53.03-3.06.04-4.6 1.41-.7 2.84-1.37 4.17-2.09v-.73l5.67 2.85c-.13.06-.29.13-.42.19-.13 1.45-.15 2.81-.15 4.21l-.66.34c1.26.01 2.52.04 3.78.04 2.19 0 4.39-.04 6.56-.08v-.27l.54.27c.83-.02 1.65-.05 2.47-.08l-3.01-1.43c.01-.94.04-1.83.08-2.78l5.37-3.02c1.32.66 2.63 1.26 3.98 1.85v5.13c.43-.02.87-.05 1.31-.07v-5.13c1.38-.75 2.69-1.55 4.01-2.35v-.39l5.32 2.35c-.14 1.59-.04 3.22-.04 4.75l.38.2c2.97-.23 5.91-.51 8.84-.81.05-.03.11-.05.16-.08l.16.08 2.51-.27-2.55-1.04c-.01-.67-.02-1.38 0-2.05l6.53-4.13c.97.4 1.93.79 2.86 1.16-.01 1.75-.01 3.5 0 5.25.43-.05.85-.13 1.28-.19.01-1.76.06-3.52.07-5.29 1.4-.83 2.78-1.64 4.13-2.47 0-.12.01-.27 0-.39l5.21 2.05c-.03.92-.03 1.82-.03 2.74l-4 2.63c.87-.13 1.73-.29 2.59-.42l1.39-.93v.66c.03.01.05.03.08.04 3.72-.61 7.41-1.28 11.08-2.01l-1.51-.54v-3.71c-.32-.11-.64-.2-.96-.31l6.06-4.17v1.08c1.35.53 2.79 1.02 4.17 1.47.01 1.5.03 3 .04 4.51l1.23-.27c-.03-1.5-.04-3-.04-4.52 1.39-.95 2.83-1.83 4.28-2.78l5.17 1.62c-.04 1.16-.03 2.28 0 3.39 3.22-.83 6.41-1.7 9.57-2.63v-2.32l5.64-4.4c1.3.38 2.59.7 3.89 1.04v2.7c.4-.13.8-.25 1.2-.38v-2.82c1.31-1.01 2.65-2.03 4.06-3.09-.03-.62-.03-1.23-.04-1.85l6.79 1.77c-.52.4-1.06.77-1.58 1.16-.03.56 0 1.09 0 1.62 3.23-1.18 6.45-2.42 9.61-3.7v-.5c-.17-.04-.33-.04-.5-.08l5.52-4.71v.62c1.28.31 2.57.62 3.86.89.94-.42 1.88-.84 2.81-1.27 1.01-.82 2-1.65 3.01-2.47-.02-.54-.02-1.11-.03-1.66l3.98.81 1.89-.93-5.87-1.16c0-.65-.07-1.28-.07-1.93-.3-.08-.58-.13-.89-.2l5.95-5.33c1.33.28 2.8.53 4.2.77.04 2.05.05 4.1.08 6.14.44-.23.88-.47 1.31-.7-.02-1.96-.05-3.91-.07-5.87 1.34-1.17 2.72-2.37 4.09-3.59 0-.3.01-.62 0-.92l6.95 1.2c-.55.49-1.13.98-1.7 1.47v2.66c3.7-2.13 7.31-4.33 10.85-6.6l5.4-5.21 1.93.27c1.9-1.32 3.76-2.62 5.6-3.98.12-.11.24-.2.35-.31-.01-.21.01-.44 0-.66l.85.12c.49-.37.98-.75 1.47-1.12l-2.35-.27c-.02-.57-.03-1.12-.04-1.7l6.14-6.21c.96.1 1.92.2 2.89.27.02.89.02 1.78.04 2.67.46-.38.9-.75 1.35-1.12l-.03-1.97c.38-.37.77-.72 1.15-1.08l-2.66-8.65c0 .31.03.61.04.92-.51.51-1 1.01-1.51 1.5l-7.64-.85c-.03-1.07-.04-2.14-.08-3.24-.17-.02-.33-.02-.5-.04l5.55-5.67v.77c.73.05 1.45.11 2.16.16l-1.7-5.71c-.15.15-.3.31-.46.46-.04 1-.09 2-.07 2.97l-6.79 6.83c-.83-.1-1.66-.2-2.47-.31-.05-2.27-.03-4.53-.08-6.79 1.29-1.23 2.56-2.52 3.9-3.82l5.94.61-.42-1.43-5.33-.54c-.03-1.04-.09-2.07-.12-3.13l3.28-3.4-.31-1.08-3.55 3.67c-1.19-.13-2.39-.26-3.63-.39-.03-2.28-.1-4.6-.15-6.87 1.23-1.21 2.52-2.43 3.86-3.67l1.27.11-.39-1.35-.58-.04c-.02-.64-.02-1.29-.04-1.93l-.66-2.2c-.19-.02-.38-.02-.58-.04l.43-.43-.35-1.08-1.31 1.35c-.59-.06-1.18-.16-1.78-.23-.06-2.28-.14-4.55-.19-6.83l1.2-1.16-2.74-8.95c.01.47.03.95.04 1.43-1.19 1.12-2.35 2.27-3.47 3.4l-5.59-.54c-.04-1.39-.07-2.81-.07-4.25-.08-.01-.15.01-.23 0l5.87-5.98c.54.06 1.15.11 1.74.15l-2.12-6.91-5.52-.47v-2.78l3.43-3.59-.31-1.04-4.18 4.33c-1.1-.08-2.17-.12-3.24-.23-.02-2.28-.02-4.55-.04-6.83 1.39-1.33 2.8-2.72 4.13-4.05v-.04l1.28.12-.46-1.46-.85-.08c-.03-.98-.09-1.97-.08-3.01l-.35-1.2-.5.54c-1.12-.04-2.25-.09-3.36-.19-.04-2.25-.11-4.52-.15-6.8l1.58-1.55-2.97-9.72c.01.9.03 1.8.04 2.7-.87.86-1.77 1.67-2.67 2.51l-6.44-.73c-.01-1.44-.04-2.89-.04-4.37h-.08l5.17-5.25c.01.14 0 .29 0 .43.87.1 1.76.18 2.67.27l-1.86-6.02c-.04.03-.08.08-.12.11l-5.87-.66c.04-.03.05-.05.08-.08-.02-.55-.03-1.13-.04-1.7-2.71 2.01-5.51 3.97-8.41 5.87.98.13 1.95.23 2.97.31.01 2.32.06 4.64.08 6.95-1.12 1.04-2.24 2.1-3.32 3.13l-5.79-.77c-.02-1.26-.06-2.53 0-3.86-.05 0-.07.01-.12 0l5.33-5.21c-1.33.86-2.68 1.71-4.05 2.55l-2.57 2.47c-.43-.06-.85-.13-1.27-.19-2.33 1.35-4.7 2.65-7.14 3.93l.47.08c-.05 1.26-.03 2.48 0 3.67l-5.86 5.4c-1.12-.17-2.23-.33-3.32-.54-.01-1.42-.03-2.85-.04-4.28-.4.19-.79.39-1.19.58-.01 1.4-.05 2.81-.04 4.21-1.13.98-2.23 1.93-3.32 2.85l-5.83-1.31c-.01-.58-.03-1.16-.04-1.74-3.06 1.25-6.16 2.49-9.34 3.63 0 .39.03.78.04 1.16l-5.83 4.82c-1.15-.32-2.29-.61-3.4-.93.01-.66-.03-1.317-.03-1.97-.38.12-.78.23-1.16.35v2.01c-1.03.78-2.06 1.52-3.09 2.272l-5.83-1.66c-3.27.9-6.59 1.76-9.96 2.55l-5.75 4.2c-1.11-.34-2.17-.71-3.2-1.08v-1c-.41.085-.82.153-1.24.234v1.2c-1.37.96-2.73 1.9-4.17 2.89v.15l-5.02-1.78v-.81c-3.11.51-6.25.97-9.42 1.39v.81c1.3.63 2.64 1.17 4.02 1.66v6.6c-1.13.72-2.27 1.43-3.43 2.12l-5.91-2.32c.07-.04.09-.07.15-.12 0-1.54-.07-3.08 0-4.67-.24-.09-.42-.19-.66-.27l5.75-3.83c-.79.1-1.59.21-2.39.31l-4.59 3.05c-.72-.27-1.43-.54-2.12-.85v-1.46c-.4.04-.8.07-1.2.11v1.51c-1.37.86-2.74 1.63-4.13 2.39v.07l-5.09-2.28c0-.31.007-.62 0-.93-3.12.2-6.26.35-9.42.46v.62l-5.79 3.36c-1.13-.52-2.3-1.05-3.36-1.62v-2.12h-1.31c-.01.72.01 1.44 0 2.16-1.36.71-2.75 1.47-4.05 2.12l-5.13-2.59v-1.82c-3.18-.08-6.32-.22-9.46-.39v1.15l-6.29 3.09c-.95-.5-1.91-1.01-2.78-1.55v-3.32l-1.23-.12c-.004 1.11-.024 2.22-.04 3.32-1.26.56-2.56 1.13-3.78 1.7l-5.56-3.21c-.01-.91 0-1.85.04-2.78-3.145-.37-6.284-.77-9.38-1.23-.034.99-.1 1.96-.11 2.93l-5.8 2.47c-1.09-.69-2.18-1.35-3.24-2.01.01-1.63.03-3.28.04-4.94-.4-.07-.8-.16-1.19-.23-.02 1.66-.03 3.31-.04 4.94-1.31.49-2.6.97-3.86 1.39l-5.52-3.74c-.01-1.28.01-2.55.12-3.82l1.08-.43c-.72-.16-1.45-.3-2.17-.46-.03-.01-.05-.04-.08-.04-2.11-.48-4.22-.95-6.29-1.47l-1.16.34-1.51-1.04c-.945-.25-1.88-.52-2.82-.78l3.47 2.47c-.03 1.22-.05 2.36-.08 3.51l-5.71 2.04c-1.08-.77-2.2-1.56-3.28-2.36.02-2.24.03-4.5.04-6.76.29-.08.57-.16.85-.23-1.36-.4-2.71-.82-4.05-1.24.64.47 1.29.93 1.93 1.43-.04 2.11-.04 4.21-.04 6.33-1.18.35-2.3.68-3.475 1l-5.94-4.36c.02-.96.03-1.93.08-2.9l6.14-1.93c-.7-.22-1.4-.44-2.09-.66l-4.6 1.47c-1.12-.81-2.23-1.68-3.32-2.55.01-.54 0-1.08 0-1.62l-1.27-.47c-.01.59-.03 1.18-.04 1.77-.99.28-1.92.5-2.85.74l-6.483-5.06c0-.38.02-.77.04-1.16-3.18-1.32-6.3-2.69-9.34-4.13-.03 1.03-.04 2.06-.04 3.09.15.12.31.26.46.39l-5.99 1.46c-1.18-.94-2.33-1.98-3.47-3.01.01-2.14.04-4.27.08-6.41-.6-.32-1.19-.65-1.78-.97.186.16.35.34.54.5-.01 2.15-.02 4.26 0 6.41-1.05.17-2.08.33-3.09.5l-6.37-5.52c.027-.73.06-1.5.08-2.24l5.985-1.23c-.55-.31-1.12-.61-1.66-.93l-4.29.89c0-.24-.01-.49 0-.73-1.28-1.14-2.51-2.27-3.79-3.36 0-.57-.01-1.16 0-1.74-.41-.26-.83-.54-1.24-.81-.01.66-.03 1.32-.04 1.97-1.18.17-2.33.34-3.51.54l-5.83-5.29c.01-1.18.03-2.34.08-3.51-.98-.46-1.92-.96-2.89-1.43zm306.01 5.133l6.485.695c-.03.91-.05 1.812-.038 2.702l-6.37 6.37c-.932-.1-1.894-.212-2.778-.31-.018-2.292-.033-4.55-.04-6.87.885-.847 1.814-1.72 2.742-2.587zm-303.385.927l5.25 4.747c0 1.448-.015 2.962-.078 4.4.274.225.58.427.85.657l-6.022 1.08c-1.238-1.242-2.557-2.467-3.822-3.666.014-2.278.103-4.593.116-6.87 1.25-.09 2.445-.182 3.706-.348zm7.295 10.923c.702.614 1.39 1.202 2.123 1.814-.044 2.194-.11 4.364-.155 6.524-1.018.15-1.994.29-2.972.47l-6.29-5.63c.002-.63.032-1.26.037-1.89l7.257-1.27zm6.484 1.544l5.83 5.095c-.07 1.576-.01 3.173-.07 4.748l-5.09 1.042v-.154c-1.27-1.085-2.57-2.222-3.82-3.32.03-2.308.03-4.64.08-6.948 1.03-.145 2.07-.292 3.09-.463zm276.41.27l5.29.772c-.03 1.1-.01 2.15 0 3.24l-6.95 6.56c-.74-.11-1.5-.18-2.24-.31-.01-2.25-.06-4.51-.07-6.8 1.3-1.16 2.63-2.32 3.98-3.48zm13.86 1.853l5.99.733c-.07 1.668-.07 3.288 0 4.903l-5.05 5.017v-.193c-1.37-.146-2.75-.316-4.09-.463-.04-2.28-.07-4.562-.07-6.87 1.09-1.025 2.16-2.077 3.24-3.127zm-304.04.772l5.06 4.632c-.03.005-.04-.005-.07 0-.07 1.59-.04 3.196-.11 4.786l.31.27-5.86 1.043c-1.13-1.052-2.27-2.102-3.4-3.127.04-2.27.09-4.58.12-6.87 1.34-.14 2.59-.276 3.98-.502v-.232zm281.19.733c1.23.252 2.44.44 3.71.618.02 2.326.03 4.603.04 6.91-.86.76-1.74 1.527-2.62 2.277l-6.37-1.08c-.04-1.26-.07-2.49-.07-3.784l5.33-4.94zm14.44 1.776c1.23.18 2.53.29 3.82.43.05 2.34.11 4.66.16 6.95-1.02.94-2.08 1.9-3.16 2.86l-5.98-.88c.01-1.18-.01-2.36.04-3.59-.25-.03-.49-.04-.73-.07l5.87-5.67zM276 496.46c.87.7 1.76 1.37 2.626 2.05-.036 2.13-.09 4.28-.116 6.41-1.263.26-2.45.51-3.667.77l-5.79-4.75c.016-.91.076-1.81.117-2.74l6.83-1.732zm275.287.54c.998.11 1.964.22 2.935.31.046 2.34.106 4.65.118 6.99-.787.78-1.603 1.578-2.432 2.36l-6.64-.85c-.036-.892-.056-1.787-.075-2.7l6.097-6.1zm-268.337.93l5.903 4.71c-.033.01-.045.07-.077.08-.067 1.48-.068 2.927-.117 4.4l-5.14 1.35c-1.28-1-2.61-2-3.86-3.05.05-2.26.15-4.572.19-6.87 1.05-.21 2.07-.4 3.09-.614zm218.273 1.89l5.44 1.16c-.04 1.27-.043 2.54 0 3.75l-6.406 5.56c-.96-.205-1.88-.425-2.82-.62v-6.753c1.253-1.002 2.48-2.02 3.784-3.087zm-240.393.16c1 .894 2.03 1.79 3.09 2.664-.03 2.154-.046 4.33-.078 6.485-.83.13-1.625.26-2.434.38l-6.907-5.98c.02-.74.02-1.49.04-2.24l6.3-1.31zm8.145 2.085l5.133 4.246v4.29c.115.1.266.21.385.31l-5.982 1.51c-1.17-.9-2.3-1.85-3.43-2.82.05-2.24.03-4.49.08-6.75 1.28-.2 2.53-.46 3.82-.77zm223.37.54c1.21.306 2.47.547 3.783.773.012 2.29.02 4.6 0 6.87-.735.605-1.46 1.18-2.2 1.776l-6.988-1.7c-.014-1.07-.028-2.132 0-3.202l5.404-4.515zm23.005.04l5.907.964c-.018.01-.024.02-.04.04-.047 1.14-.054 2.23-.038 3.32l-6.33 5.83c-.96-.15-1.92-.33-2.86-.47-.02-2.22-.07-4.41-.12-6.64 1.12-.97 2.28-2.03 3.47-3.05zm-268.646.19c.9.77 1.8 1.556 2.66 2.316-.04 2.15-.11 4.26-.152 6.41-1.467.21-2.87.45-4.362.7v.43l-5.17-4.63c.077-.01.153-.06.23-.07 0-1.31.02-2.64.078-3.93l6.716-1.2zm7.565 1.776l5.83 5.06c-.17.03-.35.09-.51.12-.07 1.52-.06 3.08-.12 4.59l-5.17 1.04c-1.29-1.14-2.54-2.24-3.79-3.31.04-2.27.07-4.54.07-6.83 1.22-.19 2.43-.44 3.66-.65zm276.33.54l5.09.74c0 1.53.07 3.05.07 4.59.14.03.31.02.46.04l-5.87 5.6c-1.26-.2-2.49-.39-3.67-.65-.01-2.25-.07-4.5-.12-6.75 1.38-1.15 2.72-2.32 4.01-3.47v-.07zm-23.2.23c1.08.29 2.2.57 3.32.78.01 2.24.03 4.44.07 6.64-.95.82-1.9 1.64-2.86 2.39l-6.37-1.43c-.02-1.07-.01-2.15.04-3.2-.05-.01-.11-.03-.16-.04l5.94-5.13zm37.24 1.78l5.9.74c-.06.05-.14.1-.2.15 0 1.41.1 2.83.11 4.25l-5.52 5.44c-1.24-.13-2.44-.3-3.63-.46-.05-2.3-.07-4.63-.08-6.95 1.12-1.04 2.23-2.09 3.39-3.16zm-72.18.7l5.13 1.35c-.1 1.46-.04 2.88-.04 4.29l-6.06 4.79c-1.07-.24-2.12-.48-3.13-.77-.01-2.18-.02-4.4 0-6.6 1.32-.96 2.65-1.97 4.09-3.05zm-182.92.27c1.07.84 2.21 1.63 3.32 2.47-.02 2.15-.08 4.31-.12 6.45-.91.26-1.79.52-2.66.74l-6.6-5.17c0-.93.01-1.86.03-2.82l6.02-1.66zm-51.11.04l6.4 5.83c.01 1.04.04 2.07 0 3.09.09.08.22.16.31.23l-5.95 1.08c-1.14-1.04-2.29-2.12-3.4-3.16.03-2.26.04-4.53.07-6.79.84-.08 1.71-.17 2.55-.27zm282.77.08v.35c1.32.24 2.61.5 3.93.7.05 2.28.11 4.55.15 6.83-.73.65-1.46 1.28-2.16 1.89l-6.91-1.19c-.02-1.27-.08-2.53-.08-3.82l5.05-4.74zm-223.26 1.39l5.21 3.9c-.05 1.39-.08 2.75-.08 4.13l-6.03 1.9c-1.02-.7-2.02-1.4-3.01-2.16.03-2.23.04-4.44.07-6.68 1.28-.35 2.53-.68 3.82-1.08zm165.32.39c1.24.46 2.52.85 3.86 1.2v6.68c-1.25.94-2.52 1.83-3.75 2.66l-5.99-1.93c.18-.12.39-.21.58-.34 0-1.45-.05-2.84 0-4.32l5.28-3.94zm74.18.7c.8.11 1.6.23 2.43.31.04 2.32.1 4.61.11 6.91-.82.76-1.66 1.54-2.47 2.28l-6.68-1c-.01-.7-.02-1.43 0-2.16l6.6-6.33zm-50.8.85l5.98 1.51c-.14.12-.29.24-.43.35-.05 1.26-.07 2.46-.08 3.67l-5.95 4.9c-1.08-.3-2.17-.52-3.24-.85-.02-2.21-.03-4.43-.04-6.64 1.27-.94 2.46-1.96 3.74-2.93zm-211.06.27c.88.72 1.76 1.43 2.66 2.16-.01 2.17-.04 4.35-.08 6.49-.97.22-1.94.39-2.9.58l-6.41-5.17c.01-.77.03-1.57.07-2.35l6.64-1.69zm275.55 1.31c1.11.16 2.2.29 3.32.39.04 2.31.1 4.64.15 6.95-.63.61-1.26 1.19-1.9 1.78l-7.18-.88c-.01-.88-.06-1.76-.08-2.66l5.67-5.56zm-268.3.27l5.94 4.67c-.06.02-.14.03-.2.04 0 1.33-.06 2.61-.08 3.86l-5.75 1.62c-1.1-.86-2.15-1.76-3.25-2.62.01-2.27.03-4.53.04-6.83 1.1-.24 2.17-.47 3.28-.73zm35.31.31c1.2.88 2.42 1.75 3.66 2.59-.05 2.17-.07 4.3-.08 6.45-.92.31-1.81.61-2.7.89l-6.6-4.63c0-1.08 0-2.21.07-3.32l5.64-1.97zm160.34.27c1.05.3 2.11.62 3.16.89.03 2.16.05 4.34.08 6.53-1.35 1.05-2.7 2.05-4.09 3.05v.23l-5.13-1.43c-.01-1.49-.05-2.98.07-4.63l5.91-4.63zm-36.29.66l6.18 2.2c0 .91.03 1.82.04 2.71L442 524.1c-.926-.33-1.85-.66-2.74-.96v-6.64c.967-.61 1.95-1.18 2.935-1.81zm59.36.47l5.17 1.12c-.05 1.27-.02 2.54 0 3.78l-6.83 5.87c-.8-.17-1.6-.35-2.36-.54-.04-2.19-.05-4.4-.08-6.64 1.38-1.11 2.77-2.24 4.09-3.32 0-.09.01-.17 0-.27zm-174.97.08l5.6 3.86c-.05 1.15-.04 2.29-.08 3.44l-6.29 2.4c-.95-.63-1.9-1.27-2.82-1.89.03-2.23.08-4.43.11-6.68 1.16-.36 2.31-.7 3.47-1.12zm-66.16.27c1.07.93 2.15 1.89 3.2 2.82-.05 2.14-.04 4.25-.08 6.37-1.45.27-2.89.53-4.29.77l-5.83-5.02c.25-.04.49-.11.74-.15 0-1.13.01-2.28.04-3.43l6.21-1.35zm8.41 1.7l5.09 4.21c-.07 1.58-.09 3.1-.15 4.63l-6.13 1.5c-.98-.75-1.99-1.5-2.9-2.35.04-2.23.05-4.5.08-6.75 1.32-.23 2.69-.45 4.01-.77v-.46zm78.24.43c1.21.76 2.55 1.51 3.82 2.27-.03 2.17-.09 4.34-.12 6.49-1.04.39-2.07.83-3.09 1.24l-6.18-3.86c-.01-1.27-.04-2.51.04-3.78l5.52-2.36zm66.27.42l6.06 2.66c-.02.63 0 1.23 0 1.86l-7.49 4.55c-.57-.23-1.12-.49-1.7-.73v-6.56c1.06-.59 2.1-1.16 3.13-1.77zm102.63.04l5.9 1.05-.54.47c-.07 1.69.01 3.24.07 4.87l-5.1 4.63v-.15c-1.36-.24-2.76-.46-4.13-.69-.05-2.27-.11-4.54-.16-6.79 1.28-1.09 2.6-2.18 3.94-3.36zm-23.66.24c1.28.31 2.57.55 3.9.81.02 2.25.03 4.49.08 6.72-1.23 1-2.36 1.98-3.51 2.9l-5.83-1.5c.03-.02.04-.09.08-.11 0-1.44-.03-2.95-.04-4.4l5.33-4.4zm-86.93.08c1.13.58 2.4 1.08 3.63 1.62-.02 2.2-.1 4.39-.12 6.56-1.11.62-2.2 1.27-3.28 1.86l-5.95-2.78c0-1.34.07-2.64.12-3.97l5.6-3.28zm-159.07.04c.91.78 1.81 1.57 2.7 2.35-.05 2.17-.11 4.35-.16 6.53-1.45.24-2.8.49-4.21.73l-5.06-4.55c0-1.25-.03-2.53.04-3.82l6.68-1.23zm109.46.11l5.4 3.17c-.05 1.02-.01 1.99 0 2.98l-6.87 3.16c-.74-.44-1.47-.87-2.2-1.31.01-2.17.02-4.36.04-6.56 1.19-.47 2.4-.96 3.63-1.43zm-51.76.04c1.06.8 2.19 1.59 3.28 2.4-.04 2.14-.09 4.25-.12 6.37-1.1.32-2.23.62-3.36.93l-5.94-4.44c.03-1.08.03-2.18.08-3.32l6.06-1.93zm71.79.23v.19c1.31.83 2.75 1.54 4.13 2.24-.03 2.16-.08 4.32-.08 6.48-1.03.5-2.03.96-3.09 1.43l-6.14-3.32c0-1.49.01-2.94.08-4.44v-.08l5.09-2.51zm8.53.7l6.02 2.98c-.03 1.12-.04 2.23-.04 3.32l-6.1 3.36c-1.05-.49-2.11-.95-3.17-1.42.03-2.21.08-4.38.08-6.56 1.04-.55 2.12-1.09 3.2-1.66zm71.83 0l6.87 2.24c-.03 1.02-.08 2.06-.08 3.05l-5.71 4.13c-1.15-.37-2.29-.81-3.44-1.16 0-2.2 0-4.39-.04-6.6.79-.55 1.6-1.11 2.39-1.66zm-144.24.5l5.83 4.09c-.06.02-.14.02-.2.04-.06 1.29-.04 2.57-.04 3.82l-5.93 2.04c-1.06-.77-2.11-1.54-3.13-2.31.02-2.22.07-4.45.08-6.71 1.14-.31 2.25-.61 3.4-.96zm137.22.31c1.13.41 2.26.78 3.39 1.16 0 2.2.02 4.36.03 6.53-1.23.84-2.46 1.72-3.78 2.63l-5.44-1.93c0-1.38.02-2.72.08-4.09-.08-.03-.15-.09-.23-.12l5.94-4.17zm-196.93.27l6.98 6.14v2.82l-5.59 1.2c-1.17-.95-2.34-1.9-3.44-2.86.05-2.32.07-4.62.12-6.95.64-.1 1.29-.24 1.93-.34zm278.56.04l5.36.85c-.06.05-.14.06-.19.11-.07 1.61 0 3.28 0 4.9l-5.91 5.6c-1.12-.17-2.22-.39-3.28-.58-.04-2.26-.05-4.51-.08-6.79 1.39-1.15 2.76-2.29 4.09-3.47v-.61zm-23.78.42c1.25.32 2.51.65 3.82.93.04 2.25.09 4.49.11 6.72-1.31 1.13-2.64 2.18-4.05 3.24l-5.14-1.15c-.01-1.47-.08-2.91-.08-4.48-.2-.04-.42-.11-.62-.15l5.94-5.09zm-275.87.04c1.04.97 2.1 1.94 3.16 2.9-.03 2.15-.04 4.32-.08 6.49-1.3.13-2.54.29-3.75.43l-5.79-5.32h.12c.05-1.09.06-2.24.11-3.4l6.21-1.08zm314.35 1.58l5.94.78-.77.74c-.06 1.42-.02 2.8 0 4.17l-5.56 5.45c-1.26-.14-2.46-.33-3.67-.46-.03-2.26-.08-4.51-.12-6.79 1.36-1.23 2.73-2.5 4.17-3.82v-.04zm-256.68.54c1.23.99 2.54 1.91 3.78 2.86-.02 2.17-.11 4.33-.12 6.48-.7.21-1.37.4-2.05.58l-7.22-5.59c0-.88.01-1.78.03-2.7l5.56-1.62zm44.04.23c1.13.78 2.31 1.53 3.51 2.2 0 2.14-.06 4.29-.07 6.41-1.06.42-2.11.78-3.17 1.12l-6.21-4.13c-.02-1.12.01-2.19.11-3.28-.02-.01-.02-.06-.04-.08l5.87-2.24zm86.46.39v.31c1.31.69 2.74 1.27 4.13 1.78.04 2.19.07 4.36.08 6.53-1.34.82-2.67 1.58-4.02 2.32l-5.29-2.35c.01-1.39.03-2.85.16-4.28-.41-.16-.8-.33-1.2-.5l6.14-3.78zm-180.68.08l5.87 5.33c-.03 1.12-.04 2.24-.08 3.36l-6.1 1.04c-.97-.85-1.93-1.68-2.86-2.55-.02-2.31.02-4.6.07-6.87 1.02-.08 2.06-.16 3.09-.31zm188.82.04l6.48 2.55c-.06 1.06-.01 2.06 0 3.09l-5.95 3.98c-1.13-.4-2.19-.85-3.24-1.31v-6.6c.9-.52 1.79-1.1 2.7-1.7zm43.96.31l6.13 1.7c0 .98.04 2 .04 2.98l-6.29 4.94c-.99-.25-1.96-.51-2.89-.81-.03-2.16-.05-4.32-.08-6.48 1.02-.73 2.04-1.53 3.09-2.32zm-130.78.15l5.99 3.78c0 1.07-.01 2.12-.04 3.17l-6.3 2.66c-.9-.56-1.8-1.15-2.7-1.7 0-2.25 0-4.5.04-6.76 1.03-.37 2.02-.75 3.05-1.15zm219.4.04l5.56.5c-.09.09-.21.18-.31.27 0 1.45.04 2.9.08 4.32l-6.56 6.68c-.94-.08-1.89-.13-2.81-.23-.04-2.23-.03-4.47-.08-6.71 1.39-1.37 2.75-2.71 4.13-4.09v-.73zm-37.44.23c.91.16 1.83.32 2.74.46.05 2.34.11 4.68.16 6.99-1.1.95-2.23 1.9-3.32 2.86l-5.91-1.16c.02-.02.05-.01.08-.04-.04-1.08-.03-2.21-.04-3.32l6.3-5.79zm-224.8.39l5.06 3.74c0 1.41-.02 2.77-.08 4.13l-6.26 1.97c-.93-.68-1.84-1.34-2.74-2.04.04-2.21.05-4.46.08-6.72 1.32-.35 2.62-.67 3.94-1.08zm165.63.62c1.2.41 2.46.78 3.67 1.12.01 2.23.06 4.41.07 6.64-1.07.8-2.13 1.57-3.2 2.32l-6.06-2c0-1.28-.03-2.63-.04-3.97l5.56-4.09zm-240.01.08l6.45 5.91c-.04 1.16-.04 2.32-.04 3.47l-5.67.93c-1.14-1.02-2.26-2.09-3.36-3.12.06-2.31.14-4.6.19-6.91.81-.07 1.62-.15 2.43-.27zm138.15.04c1.24.73 2.53 1.47 3.82 2.16v6.37c-1.18.54-2.4 1.12-3.63 1.66l-5.75-3.39c.01-1.21.01-2.42.12-3.63-.21-.12-.37-.27-.58-.39l6.02-2.78zm36.82.58l6.49 3.05v1.97l-7.02 4.05c-.72-.34-1.43-.7-2.12-1.04-.03-2.21-.04-4.41-.04-6.64.9-.45 1.8-.91 2.7-1.39zm-7.02.04c1.02.54 2.05 1.06 3.09 1.55v6.53c-1.18.63-2.37 1.21-3.55 1.78l-5.72-2.82c0-1.19.03-2.41.08-3.63l6.1-3.39zm-21.73.12l6.1 3.28c-.05 1.28-.04 2.58 0 3.82l-5.56 2.78c-1.2-.6-2.38-1.23-3.51-1.89v-6.72c.97-.42 1.97-.83 2.97-1.27zm167.44.2c.98.14 1.96.24 2.97.35.05 2.3.11 4.55.16 6.83-1.23 1.13-2.45 2.34-3.67 3.47l-5.48-.89c-.03-1.36-.01-2.69 0-4.09l6.02-5.68zm-262.28.3c1.06.83 2.16 1.67 3.28 2.51-.01 2.18-.04 4.36-.08 6.53-1.49.3-2.98.6-4.48.89v.46l-5.1-4.13c.05-.01.11-.06.15-.07 0-1.52 0-2.99.12-4.6l6.1-1.59zm210.82 1.08l6.68 1.7c-.02.84-.05 1.69-.04 2.51l-6.41 5.25c-.96-.25-1.87-.52-2.78-.81-.02-2.21-.07-4.4-.11-6.64.9-.65 1.78-1.34 2.67-2zm-202.41.66l5.94 4.67c-.27.07-.57.17-.85.23-.05 1.24-.07 2.52-.08 3.75l-5.79 1.66c-1.07-.82-2.12-1.7-3.16-2.55.02-2.28.03-4.57.08-6.87 1.29-.27 2.56-.54 3.86-.85v-.04zm34.58.27c1.27.93 2.58 1.79 3.9 2.67-.02 2.15-.03 4.31-.04 6.45l-2.58.85-6.75-4.71c-.03-1.08-.04-2.18 0-3.28l5.48-1.97zm233.37.23c1.15.16 2.36.28 3.55.39.04 2.36.07 4.71.08 7.07-1.02.98-2.06 1.92-3.09 2.86l-6.02-.85c0-1.31-.06-2.72-.08-4.09l5.56-5.36zm-72.72.39c1.08.29 2.17.55 3.32.81.01 2.22.08 4.47.12 6.68-1.23.96-2.52 1.88-3.79 2.78l-5.52-1.58c-.01-1.34-.06-2.67 0-4.09l5.87-4.6zm-35.74.08l5.79 2.09c-.01 1.16 0 2.27 0 3.44l-6.25 4.37c-.97-.38-1.96-.75-2.89-1.12-.03-2.21-.04-4.4-.04-6.6 1.12-.71 2.24-1.43 3.4-2.16zm-115.75.66l5.06 3.4c0 1.21-.04 2.41-.08 3.59l-6.79 2.55c-.75-.51-1.51-.99-2.24-1.5 0-2.22.01-4.46.04-6.71 1.33-.41 2.68-.82 4.01-1.27v-.04zm107.23.31c1.21.53 2.5.98 3.78 1.43.01 2.24.06 4.45.08 6.64-1.06.67-2.15 1.38-3.24 2.05l-6.02-2.39v-4.13l5.4-3.59zm-174.43.04c1.19 1.06 2.39 2.11 3.67 3.17-.05 2.15-.07 4.31-.12 6.41-1.27.21-2.51.41-3.74.61l-5.75-4.98c.03-.01.04 0 .07 0 0-1.32.1-2.64.19-3.98l5.68-1.23zm241.05 1.2l6.02 1.39c-.01 1.2.04 2.36.04 3.52.18.04.35.11.54.15l-5.86 5.01c-1.3-.29-2.6-.59-3.86-.89-.03-2.19-.03-4.39-.04-6.6 1.04-.86 2.12-1.73 3.17-2.59zm-154.24.66v.19c1.39.84 2.81 1.63 4.2 2.47-.01 2.18-.06 4.33-.07 6.49-.88.34-1.75.66-2.62 1l-6.6-4.13c-.02-1.3-.06-2.57 0-3.82l5.09-2.2zm58.32.04v.93c1.28.58 2.66 1.17 4.01 1.78.03 2.2.12 4.39.12 6.56-.96.54-1.93 1.08-2.89 1.62l-6.45-2.97c0-1.36.06-2.8.12-4.2-.24-.12-.49-.23-.73-.35l5.83-3.36zm8.61.66l5.95 2.59v3.21l-6.41 3.94c-.95-.41-1.87-.83-2.81-1.23v-6.52c1.16-.64 2.2-1.31 3.28-1.97zm-146.25.23l6.29 5.1v2.82l-6.25 1.59c-.92-.74-1.85-1.45-2.74-2.2.02-2.23.05-4.5.08-6.75l2.62-.54zm225.07.12v.31c1.33.33 2.68.65 4.05.97.02 2.25.07 4.48.08 6.72-.97.8-1.93 1.59-2.9 2.36l-6.29-1.62V538c-.06-.01-.13-.02-.2-.04l5.25-4.28zm-246.84.04c1.06.9 2.13 1.83 3.21 2.78-.01 2.15-.04 4.26-.08 6.41-1.4.24-2.71.5-4.13.7l-5.25-4.71c.05-1.32.05-2.69.16-4.01l6.1-1.16zm110.39.12l5.25 3.05c.01 1.33.07 2.69.08 3.98l-6.24 2.87c-1-.58-1.98-1.24-2.94-1.85v-6.6c1.29-.49 2.56-.98 3.86-1.46zm-52.07.16c1.11.8 2.26 1.59 3.36 2.39-.04 2.18-.06 4.34-.07 6.49-.87.26-1.77.52-2.67.77l-6.67-4.94c.01-.92 0-1.85.04-2.82l6.02-1.89zm72.53.66c1.18.67 2.32 1.33 3.51 1.93 0 2.16-.01 4.33-.04 6.49-.96.47-1.83.96-2.74 1.39l-6.48-3.47c0-1.16.05-2.31.08-3.47l5.67-2.85zm8.37 0l5.63 2.71v2.39l-7.49 4.13c-.58-.28-1.2-.56-1.77-.85v-6.52c1.22-.61 2.44-1.22 3.63-1.86zm72.02.04l6.37 2.16c0 1.11.08 2.25.12 3.36l-5.75 4.25c-1.18-.36-2.3-.75-3.44-1.12-.01-2.22-.11-4.47-.11-6.71.93-.62 1.87-1.28 2.82-1.93zm58.21.39l6.67 1.2c-.04 1.22-.04 2.44 0 3.66l-5.33 4.83c-1.33-.27-2.61-.48-3.9-.7l-.03-6.72c.86-.74 1.69-1.5 2.58-2.27zm-202.95.31l5.79 4.09c-.13.04-.27.12-.39.16v3.25l-6.41 2.24c-.9-.63-1.81-1.22-2.66-1.85.04-2.26.07-4.52.08-6.79 1.18-.33 2.35-.72 3.59-1.08zm137.45.5c1.05.38 2.13.75 3.2 1.08.03 2.21.05 4.41.08 6.6l-3.63 2.51-5.64-1.97c-.02-1.31-.06-2.56 0-3.93l5.98-4.28zm-196.97.35l6.48 5.59v3.2l-5.75 1.24c-1.11-.95-2.25-1.86-3.32-2.78.04-2.27.1-4.57.15-6.87.83-.13 1.63-.28 2.43-.43zm-22.12.15c1.18 1.11 2.44 2.21 3.7 3.36-.06 2.18-.17 4.36-.19 6.52-1.09.14-2.12.3-3.17.43l-6.18-5.71c.04-1.2.04-2.42.08-3.63l5.75-.97zm278.37.93c.77.17 1.54.34 2.32.5.03 2.25.08 4.49.11 6.71l-3.52 2.86-5.73-1.37c0-.97.05-1.94.07-2.93l6.76-5.79zm21.69.46l5.82.93c-.01 1.26 0 2.47 0 3.67l-6.13 5.7c-1.04-.18-2.08-.4-3.09-.58-.04-2.26-.05-4.51-.08-6.79l3.47-2.93zm-198.09.77c1.26.87 2.62 1.75 3.93 2.55-.03 2.17-.03 4.29-.03 6.45-.99.39-1.97.75-2.97 1.08l-6.41-4.25c0-1.06.03-2.12.07-3.2-.16-.11-.31-.21-.47-.31l5.86-2.32zm-43.85.15c1.24 1 2.59 1.94 3.93 2.9-.04 2.17-.07 4.34-.11 6.53-1.31.34-2.6.67-3.86 1.01l-5.98-4.63c.17-.04.33-.07.5-.11 0-1.34.1-2.77.16-4.17l5.36-1.5zm139.18.62l6.33 2.47c-.03 1.2-.01 2.38 0 3.55l-5.59 3.78c-1.21-.45-2.42-.89-3.55-1.35-.03-2.21-.04-4.4-.04-6.64.93-.56 1.88-1.19 2.86-1.81zm44.43.19l5.68 1.62v2.98l-6.76 5.25c-.82-.21-1.63-.45-2.39-.69-.04-2.17-.04-4.34-.04-6.53 1.21-.88 2.34-1.78 3.55-2.66zm-233.6.04l5.71 5.17c-.03 1.15-.03 2.28-.08 3.4l-6.37 1.2c-.89-.82-1.79-1.63-2.66-2.43.06-2.28.13-4.6.2-6.91 1.07-.11 2.13-.26 3.2-.42zm102.56.08l5.87 3.67c-.14.06-.25.06-.39.12v4.09l-5.56 2.36c-1.2-.73-2.42-1.42-3.55-2.12.03-2.25.04-4.5.04-6.76 1.19-.45 2.36-.9 3.59-1.35zm79.17.31c1.09.48 2.23.96 3.35 1.43.01 2.21.02 4.4.04 6.6-1.13.68-2.24 1.27-3.36 1.89l-5.91-2.62c-.01-1.19-.05-2.38 0-3.63l5.87-3.66zm124.25.04l6.48.93c0 1.11-.02 2.18-.04 3.24l-6.03 5.76c-1.07-.15-2.14-.31-3.2-.43-.02-2.29-.07-4.57-.12-6.87.93-.87 1.92-1.73 2.89-2.62zm-246.76.11l5.4 3.94c-.11.04-.2.05-.31.08-.06 1.31-.04 2.58-.08 3.86l-6.79 2.16c-.76-.55-1.52-1.13-2.28-1.7.02-2.22.04-4.49.04-6.71 1.34-.37 2.65-.71 4.01-1.08v-.6zm224.6.51c1.08.19 2.17.37 3.28.54.05 2.34.11 4.69.16 6.98-.91.79-1.81 1.55-2.74 2.32l-6.41-1.2c0-1.16-.05-2.3-.08-3.44l5.79-5.21zm-131.97.46v.27c1.34.74 2.78 1.35 4.13 2.04v6.52c-1.09.59-2.23 1.15-3.35 1.7l-5.91-2.9c-.02-1.21-.05-2.4 0-3.67-.37-.19-.74-.36-1.12-.54l6.25-3.44zm73.19.19c1.19.43 2.46.82 3.71 1.19.03 2.23.12 4.47.12 6.68-1.01.73-2.03 1.44-3.01 2.12l-6.29-2.12v-3.9l5.48-3.98zm-239.89.19l5.91 5.52c-.01 1.27-.06 2.57-.12 3.86l-5.79.96c-1.05-.94-2.09-1.91-3.17-2.85.04-2.36.04-4.72.12-7.11.99-.12 2.01-.25 3.05-.39zm175.35.04l5.91 2.78v3.66l-5.98 3.48c-1.05-.49-2.11-1-3.12-1.51v-6.76c1.07-.54 2.13-1.09 3.2-1.66zm-36.82.11c1.04.6 2.15 1.16 3.24 1.78 0 2.14-.05 4.3-.08 6.44-.99.45-1.99.9-3.01 1.35l-6.29-3.63c0-1.02-.01-1.98.03-3.05l6.1-2.89zm7.68.2l5.91 3.28c-.04.87-.05 1.76-.04 2.62l-6.91 3.43c-.75-.39-1.52-.81-2.24-1.2v-6.68c1.09-.48 2.19-.98 3.28-1.46zm190.02.11l5.75.54c-.04 1.39-.08 2.74.04 4.09.13.02.25.02.38.04l-5.87 5.98c-1.28-.12-2.58-.22-3.82-.35-.01-2.25-.05-4.51-.11-6.79 1.22-1.14 2.41-2.34 3.63-3.51zm-285.36.66c1.1.89 2.24 1.78 3.36 2.66-.04 2.13-.11 4.24-.15 6.37-1.4.29-2.78.56-4.17.89l-5.25-4.25c.01-1.38.09-2.75.15-4.13l6.06-1.54zm262.78.19c.99.17 2 .23 3.05.35.01 2.33.07 4.64.11 6.95-1.05.95-2.08 1.94-3.16 2.89l-6.06-1.04v-3.52l6.06-5.63zm-50.91.74l6.14 1.58c-.06 1.56-.08 3.08-.08 4.59l-5.02 4.13v-.19c-1.4-.38-2.84-.67-4.24-1.08-.02-2.21 0-4.45.04-6.68 1.04-.77 2.09-1.56 3.16-2.35zm-168.99.65v.24c1.39.99 2.74 1.89 4.13 2.78-.02 2.18-.03 4.36-.08 6.52-.64.23-1.29.42-1.93.62l-7.33-5.06c.03-1.05.03-2.09.08-3.16-.02-.01-.02-.03-.04-.04l5.17-1.9zm-36.13.62l6.79 5.37c-.01.8-.02 1.58-.04 2.36l-6.25 1.73c-.92-.69-1.82-1.38-2.74-2.09-.01-2.31.03-4.58.08-6.87.72-.16 1.45-.33 2.16-.5zm196.82.43c1.26.36 2.51.72 3.86 1.04.02 2.2.01 4.39 0 6.56-1.17.91-2.31 1.8-3.47 2.67l-5.75-1.66c0-1.27.02-2.56.07-3.9-.18-.05-.36-.07-.54-.12l5.83-4.6zm-35.09.04l5.63 2.01c-.04 1.16-.08 2.35-.08 3.51l-6.33 4.36c-.94-.34-1.87-.72-2.78-1.08-.03-2.18-.07-4.38-.07-6.56 1.2-.74 2.42-1.46 3.63-2.24zm108.03.12c1.35.19 2.68.38 3.98.5.05 2.35.07 4.68.12 7.03-.85.79-1.65 1.59-2.51 2.36l-6.6-.96c-.02-1.31-.1-2.59-.16-3.9l5.17-5.02zm-224.22.27l5.14 3.44v.04c-.07 1.53-.14 3.04-.08 4.52l-6.06 2.35c-1.04-.7-2.09-1.4-3.13-2.12.01-2.24.03-4.42.08-6.64 1.35-.4 2.7-.82 4.05-1.28v-.31zm-67.54.62c1.18 1.02 2.48 2.11 3.82 3.21-.03 2.15-.1 4.33-.12 6.48-1.18.22-2.29.46-3.43.66l-6.03-5.17c.06-1.29.11-2.64.2-3.98l5.56-1.2zm175.2.08c1.21.5 2.41.96 3.66 1.43v6.64c-.91.56-1.81 1.08-2.67 1.62l-6.67-2.58c.04-1.11.11-2.25.15-3.39l5.52-3.71zm132.85.42c.78.08 1.54.19 2.35.27.05 2.33.13 4.65.19 6.95-.98.99-1.96 1.98-2.97 2.97l-6.26-.73c-.02-.89.02-1.79.03-2.7l6.64-6.75zm-65.74.54l5.48 1.31v4.13c.02.01.05 0 .07 0l-5.98 4.98c-1.16-.26-2.22-.5-3.32-.77-.03-2.23-.07-4.41-.07-6.64 1.28-1 2.58-2 3.82-3.01zm-87.39 1.2l5.33 2.32c-.05 1.33-.08 2.64-.08 3.97l-6.18 3.78c-1.03-.43-2.03-.88-3.05-1.31.01-2.19.02-4.37.04-6.56 1.35-.75 2.67-1.44 3.93-2.2zm-67.12.04c1.23.72 2.43 1.44 3.62 2.16-.02 2.16-.03 4.33-.04 6.49-.8.32-1.6.65-2.4.96l-6.91-4.29c.02-.97.07-1.95.12-2.93l5.59-2.39zm8.95.23l5.09 3.01c-.05 1.05-.04 2.02-.04 3.05l-7.49 3.52c-.57-.34-1.15-.67-1.7-1.01.01-2.17.06-4.36.07-6.56 1.36-.52 2.7-1.11 4.06-1.62v-.39zm19.72.16v.89c1.29.82 2.68 1.51 4.05 2.2v6.45c-.77.37-1.5.74-2.24 1.08l-7.02-3.79c.02-1.19.06-2.4.12-3.62-.23-.12-.46-.23-.69-.35l5.79-2.85zm-108.19.04l5.79 4.78c-.01.93-.05 1.84-.08 2.78l-6.79 1.77c-.78-.61-1.52-1.27-2.24-1.89.04-2.26.07-4.51.11-6.76 1.05-.2 2.12-.45 3.2-.69zm35.51.19c1.27.91 2.58 1.82 3.86 2.7-.04 2.11-.05 4.22-.04 6.37-1.43.43-2.85.88-4.29 1.27v.27l-5.17-3.82c0-1.54.05-3.05.12-4.59-.13-.1-.25-.17-.39-.27l5.91-1.93zm101.98 0c1.31.6 2.66 1.14 3.97 1.73v6.52c-.93.49-1.81 1.01-2.7 1.51l-6.64-3.05c.01-1.19.07-2.41.12-3.63l5.25-3.09zm-160.03.1c1.1.94 2.21 1.89 3.35 2.86-.04 2.17-.03 4.35-.07 6.52-1.22.2-2.41.42-3.55.62l-5.9-5.21c.06-1.21.06-2.42.12-3.63l6.06-1.16zm140.07.62l5.25 2.59c-.05 1.28-.12 2.5-.12 3.79l-6.37 3.55c-.95-.46-1.93-.93-2.86-1.39.01-2.16.06-4.35.08-6.53 1.36-.67 2.7-1.33 4.02-2zm108.5.08c.98.23 1.96.46 2.93.7.03 2.28.04 4.56.04 6.84-.88.73-1.81 1.42-2.7 2.12l-6.45-1.66V555l6.18-5.09zm-36.52.2l6.25 2.09c-.08 1.02-.09 2.02-.08 3.05l-6.21 4.5c-1.01-.31-1.99-.62-2.98-.96-.01-2.18-.01-4.35-.04-6.56 1.02-.69 2-1.41 3.05-2.12zm58.75.23l5.98 1.12v4.13l-5.52 4.94c-1.27-.24-2.56-.45-3.78-.69-.02-2.23-.02-4.43-.04-6.68 1.08-.9 2.21-1.86 3.36-2.82zm-203.73.08l6.14 4.25-.96.27c-.06 1.33-.02 2.71 0 4.05l-5.72 2.01c-1.15-.81-2.29-1.63-3.44-2.44.04-2.23.07-4.46.08-6.72 1.32-.32 2.59-.64 3.89-1.08v-.35zm137.22 1.05c1.12.38 2.32.68 3.48 1.04 0 2.2.01 4.38.04 6.56-1.04.71-2.12 1.39-3.17 2.08l-6.22-2.24c.02-1.11.04-2.2.04-3.31l5.83-4.13zm-197.01.31l6.37 5.36c0 .97-.05 1.95-.07 2.93l-6.33 1.39c-.95-.81-1.86-1.61-2.78-2.4.07-2.27.11-4.54.15-6.84.88-.16 1.76-.29 2.66-.47zm-22.46.27c1.25 1.12 2.49 2.27 3.75 3.39-.04 2.18-.07 4.36-.08 6.52-1.49.2-2.99.39-4.43.54v.27l-5.98-5.48c.33-.05.66-.1 1-.16.06-1.36.02-2.76.11-4.13l5.63-.96zm278.49.92c.95.21 1.91.43 2.93.62.01 2.24.01 4.49 0 6.71-1.11.93-2.16 1.83-3.24 2.7l-5.94-1.43c-.01-1.11-.03-2.22 0-3.32l6.26-5.29zm22.81.23l5.33.93c-.03 1.25-.05 2.48 0 3.66l-6.6 6.1c-.88-.15-1.76-.32-2.62-.5-.05-2.24-.07-4.48-.12-6.72 1.38-1.15 2.68-2.29 4.01-3.47zm-243.17.54v.19c1.33 1.04 2.8 2.1 4.21 3.13-.04 2.17-.06 4.32-.07 6.45-1.15.33-2.27.61-3.36.88l-6.02-4.63c.06-1.52.09-3.03.15-4.6l5.09-1.42zm140.31.66l6.02 2.31-.19.12c-.11 1.26-.11 2.48-.12 3.74l-5.83 3.86c-1.11-.4-2.25-.82-3.32-1.27-.01-2.21-.01-4.38-.04-6.6 1.16-.7 2.32-1.41 3.48-2.17zm-95.41.04c1.07.72 2.2 1.37 3.28 2-.04 2.12-.09 4.22-.11 6.33-.85.33-1.68.65-2.55.96l-6.75-4.48c.01-.82.01-1.62.04-2.43l6.1-2.39zm8.26.5l5.91 3.67c-.2.08-.42.15-.62.23-.04.86-.04 1.74-.04 2.62l-6.83 2.9c-.74-.45-1.51-.9-2.27-1.35.01-2.22.06-4.41.07-6.64 1.25-.49 2.46-.99 3.78-1.43zm131.28 0l5.51 1.62c-.04 1.39-.03 2.79-.03 4.09.16.04.31.07.47.12l-5.91 4.59c-1.29-.35-2.55-.66-3.79-1.04 0-2.17-.01-4.37-.04-6.57 1.26-.93 2.54-1.89 3.79-2.82zm-233.6.23l5.17 4.59c-.09 1.19-.15 2.34-.16 3.47l-6.83 1.31c-.77-.69-1.52-1.37-2.28-2.05.08-2.25.1-4.52.15-6.79 1.31-.15 2.68-.31 3.94-.54zm181.6.27c1.06.45 2.11.93 3.2 1.35v6.45c-1.06.65-2.1 1.27-3.16 1.85l-6.1-2.66c0-1.09-.01-2.2.04-3.28l6.02-3.71zm124.94.04l5.91.89-.08.07c0 1.67-.03 3.33.04 4.94l-4.98 4.75v-.1c-1.41-.19-2.84-.35-4.21-.54-.04-2.27-.07-4.58-.11-6.87 1.15-1.04 2.25-2.1 3.44-3.17zm-184.27.58v.34c1.4.75 2.79 1.56 4.25 2.32-.01 2.14-.02 4.25-.04 6.37-.96.43-1.91.85-2.86 1.28l-6.52-3.7c.02-.97.07-1.95.11-2.93-.42-.24-.82-.49-1.24-.73l6.29-2.93zm-64.77.31l6.26 4.59c-.04 1.04-.08 2.11-.08 3.12l-6.02 1.97c-1.07-.73-2.11-1.45-3.09-2.2.05-2.23.06-4.49.08-6.72.95-.25 1.91-.5 2.86-.77zm226.11.07c1.13.19 2.23.42 3.36.62.05 2.31.15 4.61.19 6.91-.87.75-1.78 1.47-2.66 2.2l-6.53-1.27c-.01-1.1-.02-2.23-.04-3.36l5.67-5.1zm-59.17.04v.19c1.32.42 2.68.91 4.09 1.31 0 2.25 0 4.48.04 6.72-.79.56-1.58 1.1-2.36 1.62l-6.87-2.24v-3.9l5.09-3.71zm-64.04.31l5.9 2.74c-.08.04-.15.11-.23.15v3.78l-5.79 3.36c-1.14-.55-2.29-1.11-3.4-1.62v-6.61c1.18-.6 2.35-1.17 3.52-1.81zm-28.87.08l5.86 3.2c-.18.08-.35.19-.54.27V564l-5.9 3.01c-1.09-.58-2.22-1.19-3.28-1.77.03-2.19.04-4.38.04-6.6 1.27-.57 2.52-1.12 3.82-1.74zm20.72.27c1.12.55 2.24 1.09 3.4 1.62-.01 2.17-.06 4.29-.08 6.44-.93.49-1.84 1.03-2.78 1.5l-6.49-3.24c.02-1.02.03-2.04.07-3.05-.03-.01-.04-.06-.07-.08l5.94-3.2zm-169.18.31l7.18 6.6c-.01.66-.03 1.31-.04 1.96l-6.29 1.08-2.7-2.43c.06-2.33.14-4.67.19-7.03.55-.05 1.12-.12 1.66-.19zm338.78.11l5.25.58c-.02.83-.01 1.69 0 2.5l-7.87 7.84c-.46-.05-.89-.11-1.35-.15-.04-2.25-.11-4.51-.15-6.75 1.38-1.31 2.77-2.58 4.13-3.94v-.08zm-286.94.04c1.22.96 2.51 1.97 3.83 2.97-.03 2.19-.08 4.35-.11 6.52-1.4.28-2.66.57-3.97.85l-5.36-4.28c.05-1.34 0-2.68.12-4.05-.19-.15-.35-.25-.54-.39l6.06-1.62zm263.33 1.04c1.1.2 2.29.33 3.51.5.06 2.3.13 4.63.16 6.91-1.01.88-1.96 1.79-2.94 2.7l-6.29-1.08c-.02-1.16-.05-2.32 0-3.52-.11-.02-.24-.02-.35-.04l5.91-5.48zm-50.37.34l5.98 1.55c-.02.01-.02.02-.04.04-.06 1.37-.01 2.82 0 4.2l-5.52 4.44c-1.27-.35-2.59-.76-3.86-1.12 0-2.19-.01-4.39-.04-6.6 1.15-.82 2.33-1.65 3.48-2.51zm-168.9 1.04c1.17.8 2.38 1.56 3.63 2.39-.01 2.19-.07 4.37-.07 6.53-1.31.43-2.63.85-3.86 1.24l-5.71-4.05c.08-.02.16-.01.23-.04 0-1.33.06-2.62.12-3.97l5.67-2.09zm-36.24.04l6.41 4.98c-.05 1.21-.08 2.44-.12 3.63l-5.55 1.55c-1.17-.87-2.34-1.75-3.48-2.66.04-2.31.06-4.6.11-6.91.87-.18 1.75-.39 2.62-.58zm161.96 0l5.25 1.93c-.05 1.23-.07 2.43-.08 3.63l-6.84 4.71c-.79-.29-1.59-.59-2.36-.89v-6.56c1.33-.83 2.69-1.69 4.01-2.58v-.23zm-116.76.35l5.6 3.75-.43.16c-.09 1.06-.12 2.13-.15 3.16l-7.53 2.81c-.55-.36-1.07-.76-1.62-1.12.04-2.17.04-4.37.04-6.6 1.34-.44 2.73-.88 4.09-1.39v-.77zm153.01.74c.77.23 1.53.42 2.32.62 0 2.22.05 4.46.08 6.68-.97.72-1.92 1.43-2.89 2.13l-6.4-1.86c0-.74.02-1.47.03-2.24l6.87-5.32zm-221.13.23v.08c1.35 1.16 2.78 2.39 4.25 3.56-.03 2.14-.08 4.28-.11 6.4-1.03.17-1.99.4-2.97.58l-6.48-5.48c.03-1.33.06-2.69.11-4.01l5.21-1.12zm293.85.23c.94.14 1.9.23 2.86.35 0 2.33.03 4.68.12 7.02-.79.74-1.55 1.49-2.35 2.2l-6.75-1.04c-.01-.87-.02-1.76-.04-2.63l6.18-5.91zm-118.07.04c1.23.48 2.52.99 3.79 1.43.01 2.21.01 4.43.04 6.64-.83.51-1.65 1.04-2.47 1.55l-6.91-2.74c.02-1.08.09-2.13.12-3.24l5.45-3.63zm131.59.43c.01.24 0 .49 0 .73 1.34.19 2.74.32 4.13.47.06 2.34.14 4.63.2 6.95-.94.94-1.91 1.87-2.86 2.78l-6.44-.77c-.02-1.14-.03-2.31-.03-3.47-.49-.05-1.03-.1-1.55-.15l6.56-6.52zm-219.51.58c.01.28-.01.53 0 .81 1.31.73 2.75 1.63 4.13 2.47-.01 2.16-.03 4.28-.04 6.45-.6.26-1.17.54-1.77.78l-7.52-4.55c.01-1.07-.01-2.17.04-3.24-.17-.09-.33-.21-.5-.31l5.68-2.39zm155.55.15l5.33 1.28c-.05 1.43-.05 2.83 0 4.21l-5.98 5.02c-1.1-.24-2.17-.47-3.24-.73-.03-2.19-.09-4.38-.12-6.56 1.31-1.06 2.6-2.13 4.02-3.2zm-87.61.85l5.17 2.28c-.04 1.37-.03 2.73-.08 4.06l-6.29 3.86c-.97-.42-1.94-.84-2.89-1.23 0-2.17-.01-4.35-.04-6.52 1.36-.8 2.71-1.57 4.13-2.39v-.04zm-58.48.2l5.29 3.09c-.03.02-.04.03-.08.04-.06 1.42-.1 2.79-.15 4.17l-6.37 3.05c-.9-.52-1.79-1.07-2.7-1.62.01-2.21.01-4.4 0-6.6 1.35-.5 2.66-1.03 4.01-1.54v-.58zm-111.66.54c1.27 1.12 2.59 2.21 3.86 3.32-.04 2.16-.1 4.33-.12 6.49-1.43.26-2.97.52-4.44.77-.01.21 0 .38 0 .58l-5.37-4.71c.12-.02.23-.01.35-.03.07-1.59.05-3.19.19-4.78-.16-.13-.31-.28-.47-.42l5.98-1.19zm23.2 0l5.67 4.56c-.04 1.29-.08 2.57-.08 3.82l-6.02 1.59c-1.04-.81-2.09-1.63-3.09-2.47.04-2.22.09-4.47.11-6.71 1.11-.2 2.25-.48 3.4-.77zm137.87.12c1.2.55 2.46 1.08 3.74 1.62.01 2.22.01 4.45.04 6.64-.75.39-1.45.77-2.16 1.16l-7.22-3.32c.02-.94.08-1.9.11-2.85l5.48-3.24zm-20.19.12l5.25 2.67c-.07 1.52-.1 3.05-.16 4.52l-6.2 3.38c-1.01-.49-1.99-.98-2.97-1.47v-6.56c1.38-.69 2.78-1.37 4.1-2.12v-.42zm-81.48.35c1 .73 2.03 1.37 3.13 2.12-.04 2.16-.05 4.33-.08 6.49-1.31.4-2.58.8-3.94 1.2l-5.48-3.98c.04-1.25.09-2.53.19-3.82l6.18-2zm72.22.15c1.26.75 2.58 1.46 3.94 2.12 0 2.18 0 4.37.04 6.53-.73.35-1.43.65-2.13.97l-7.3-3.9c.03-.99.08-2.01.12-3.01l5.33-2.7zm81.87.04l6.02 2.01-.35.23c-.07 1.65-.04 3.24-.04 4.83l-5.13 3.71v-.31c-1.4-.43-2.72-.89-4.06-1.35l-.04-6.6c1.17-.81 2.38-1.66 3.59-2.51zm35.59.35c1.08.27 2.24.51 3.39.77.04 2.27.07 4.54.08 6.76-.73.6-1.47 1.17-2.2 1.74l-6.99-1.78c-.04-.92-.03-1.86-.04-2.78l5.75-4.71zm22.85.5l5.94 1.16c-.03.03-.08.05-.12.08 0 1.29.09 2.48.12 3.71l-6.06 5.29c-1.14-.19-2.24-.34-3.28-.58-.05-2.24-.04-4.48-.08-6.72 1.19-.93 2.31-1.94 3.47-2.93zm-205.66.5l6.56 4.6c-.02.65-.02 1.28-.04 1.93l-6.95 2.43c-.71-.52-1.45-.99-2.16-1.5.05-2.24.11-4.48.16-6.75.8-.22 1.61-.46 2.43-.69zm138.49.16c1.27.44 2.55.87 3.86 1.31v6.56c-.98.68-1.95 1.32-2.93 1.97l-6.37-2.28c.01-1.06.03-2.12.08-3.2-.19-.07-.39-.16-.58-.23l5.94-4.13zm-196.35.58l5.79 4.98c-.03 0-.09-.01-.12 0 0 1.44.01 2.81-.04 4.21l-5.52 1.23c-1.18-.96-2.3-1.93-3.47-2.89.04-2.33.03-4.65.07-6.99 1.12-.18 2.21-.36 3.28-.54zm-23.59.08c1.33 1.25 2.79 2.54 4.25 3.79-.07 2.18-.11 4.37-.16 6.56-1.32.19-2.6.39-3.97.54l-5.79-5.25c.13-.02.22-.06.35-.08 0-1.54.02-3.07.15-4.67l5.18-.88zm102.63 1.39c0 .09.01.18 0 .27 1.4.89 2.81 1.81 4.25 2.67-.03 2.17-.04 4.35-.04 6.52-1.44.52-2.87 1.04-4.21 1.47v.04l-5.17-3.4c.01-1.46.05-2.92.12-4.44-.39-.25-.73-.48-1.12-.73l6.18-2.39zm176.71 0c1 .23 2.04.48 3.05.7.02 2.24.04 4.43.08 6.64-.9.76-1.79 1.54-2.7 2.28l-6.6-1.62c.01-.95 0-1.89.04-2.85l6.14-5.13zm23.12.23l5.25.89c-.06 1.58-.06 3.13 0 4.71.12.03.27.09.39.12l-5.88 5.35c-1.24-.25-2.54-.48-3.74-.73-.07-2.27-.08-4.53-.12-6.79 1.39-1.13 2.76-2.26 4.09-3.39V569zm-103.05.7l6.02 2.4c-.1.06-.24.13-.35.19-.05 1.07-.04 2.13-.04 3.2l-6.33 4.21c-.99-.39-1.99-.75-2.94-1.16V572c1.21-.73 2.42-1.48 3.63-2.28zm-213.72.04c1.21 1.21 2.52 2.43 3.82 3.63-.07 2.16-.16 4.33-.23 6.49-1.44.11-2.85.27-4.36.42l-5.02-4.78c.01-1.55.09-3.13.16-4.63-.12-.11-.27-.23-.39-.35l6.02-.77zm73.57.04c1.22.91 2.41 1.8 3.63 2.7-.03 2.16 0 4.33-.07 6.49-1.09.31-2.19.61-3.24.89l-6.13-4.8c0-1.19.01-2.38.08-3.59l5.75-1.7zm52.92 0l6.37 3.9c-.4.17-.8.32-1.19.5 0 1.22-.06 2.41-.08 3.59l-5.95 2.59c-1.11-.66-2.17-1.3-3.24-1.97 0-2.22.04-4.45.08-6.68 1.33-.47 2.68-.96 4.01-1.5v-.43zm131.58.23l5.25 1.59c-.07 1.6-.07 3.21 0 4.71l-5.83 4.51c-1.16-.31-2.31-.61-3.4-.92-.01-2.18-.08-4.36-.12-6.56 1.38-.97 2.76-2.05 4.09-3.05v-.27zm-52.49.78c1.09.48 2.19.97 3.32 1.42v6.49c-.88.53-1.75 1.01-2.62 1.5l-6.64-2.89c.01-.94 0-1.86.04-2.82v-.08l5.9-3.63zm-183.38.34l6.56 5.83c-.02.93-.07 1.85-.11 2.78l-6.06 1.27c-.98-.9-1.96-1.79-2.94-2.67.05-2.3.11-4.62.16-6.91.78-.09 1.59-.19 2.39-.31zm308.91.31l6.02.92c-.11.1-.25.18-.35.27-.04.78-.01 1.56 0 2.32l-7.38 6.87c-.6-.08-1.25-.15-1.86-.23-.05-2.27-.07-4.53-.08-6.83 1.17-1.1 2.37-2.23 3.63-3.32zm-249.27.19l5.75 4.2c-.04.78-.02 1.56-.04 2.35l-7.41 2.39c-.6-.42-1.17-.84-1.74-1.27.04-2.23.07-4.47.11-6.72 1.1-.3 2.22-.64 3.32-.97zm103.09.27l5.99 2.74-.74.38v3.86l-5.9 3.36c-1.1-.52-2.18-1.08-3.28-1.63 0-2.24.04-4.46.08-6.71 1.27-.66 2.6-1.29 3.86-2zm-29.29.23l6.02 3.32c-.23.11-.5.2-.73.31 0 1.34-.08 2.63-.12 3.93L370 582.5c-1.22-.65-2.42-1.34-3.63-2.05V574c1.33-.63 2.7-1.24 4.01-1.85v-.04zm-8.57.04c1.09.58 2.16 1.21 3.32 1.85v6.41c-.78.35-1.52.74-2.28 1.08l-7.02-4.05c.02-.8.04-1.61.08-2.43-.02-.01-.02-.03-.04-.04l5.95-2.81zm160.3.04c1.3.27 2.6.5 3.94.73.05 2.34.15 4.67.19 7.02-.66.56-1.38 1.11-2.04 1.66l-7.14-1.47v-3.44l5.05-4.52zm-130.85.38c1.02.52 2.04 1.01 3.12 1.51 0 2.2-.01 4.36-.04 6.53-.89.46-1.76.92-2.63 1.35l-6.64-3.35c.02-.89.04-1.77.08-2.66l6.1-3.35zm73.3.08c.94.31 1.88.65 2.85.93.02 2.25.07 4.49.07 6.72-.75.51-1.48.98-2.2 1.47l-6.99-2.35c-.01-.72-.03-1.44-.04-2.16l6.29-4.6zm-242.56.5l6.79 6.18c-.02 1.1-.07 2.17-.08 3.24l-5.52 1.08c-1.15-1.01-2.28-2.08-3.44-3.08.05-2.36.02-4.75.08-7.14.71-.09 1.45-.16 2.16-.27zm338.97.46l5.33.62c-.06 1.61 0 3.16 0 4.67l-5.98 5.91c-1.15-.12-2.29-.2-3.36-.35-.07-2.19-.09-4.44-.12-6.68 1.41-1.31 2.77-2.63 4.13-3.94v-.23zm-286.71.19c1.03.81 2.09 1.62 3.12 2.44-.04 2.1-.1 4.21-.11 6.29-1.18.28-2.33.61-3.48.89l-6.06-4.79c.03-1.03.08-2.09.15-3.13l6.37-1.7zm213.26.43l5.83 1.55c-.18.14-.36.29-.54.43-.06 1.39-.04 2.78-.04 4.13l-5.56 4.48c-1.27-.34-2.53-.67-3.75-1.08-.02-2.2-.03-4.4-.08-6.6 1.35-.93 2.72-1.88 4.13-2.89zm-170.49.04c-.01.28-.07.53-.08.81 1.36.93 2.79 1.87 4.2 2.78 0 2.17-.04 4.3-.08 6.45-1.07.35-2.13.76-3.21 1.08l-6.13-4.28c0-1.42.01-2.92.08-4.33-.2-.14-.38-.31-.58-.46l5.79-2.04zm221.36.58c.82.14 1.67.27 2.55.39l.04 6.87c-.78.71-1.6 1.42-2.39 2.12l-6.79-1.23c-.01-.67-.04-1.34-.04-2.01l6.64-6.14zm-94.88.58l5.29 1.85v.08c-.07 1.58-.07 3.19-.07 4.71.18.06.36.13.54.19l-5.98 4.13c-1.29-.48-2.54-.98-3.74-1.47 0-2.18-.05-4.37-.07-6.57 1.34-.85 2.72-1.69 4.05-2.58v-.35zm-161.99.15l6.06 4.79c0 .8-.02 1.59-.04 2.4l-7.02 1.97c-.69-.53-1.37-1.11-2.05-1.66.04-2.25.1-4.52.12-6.79 1-.24 1.96-.48 2.93-.7zm270 .66c.01.45 0 .92 0 1.35 1.31.24 2.72.46 4.06.62.04 2.33.15 4.67.19 6.99-.58.54-1.16 1.1-1.73 1.62l-7.45-1.24c-.01-1.14.01-2.33-.04-3.47-.38-.05-.75-.07-1.12-.11l6.1-5.75zm-72.14.43c.94.27 1.88.53 2.82.77.01 2.21.04 4.41.08 6.6-.91.7-1.83 1.42-2.74 2.09l-6.57-1.93c.01-.86.01-1.73.04-2.63l6.37-4.91zm-45.28.12v.23c1.28.52 2.65 1.03 4.09 1.54v6.64c-.64.39-1.27.77-1.89 1.16l-7.41-2.89c-.02-1.06-.01-2.13.04-3.2l5.17-3.47zm-108.58.11l6.1 4.13c-.04 1.01-.08 1.99-.08 2.97l-6.25 2.4c-.97-.62-1.96-1.23-2.89-1.85.03-2.23.05-4.42.07-6.64 1.02-.32 2.04-.64 3.05-1zm-66.54.73c1.15.98 2.36 1.97 3.59 2.94-.05 2.16-.11 4.33-.16 6.48-1.43.29-2.87.61-4.36.85v.11l-5.98-5.02c.29-.06.6-.13.89-.19 0-1.24.07-2.54.16-3.86l5.87-1.31zm243.25.35l5.4 1.35c-.03.03-.04.05-.07.08-.06 1.42-.13 2.79-.08 4.17l-6.53 5.48c-.93-.22-1.81-.45-2.7-.69-.04-2.2-.06-4.4-.08-6.6 1.36-1.09 2.77-2.17 4.05-3.32V578zm-87.85.5l5.36 2.44c-.06.03-.13.08-.19.12-.06 1.34-.07 2.63-.04 3.9l-6.79 4.09c-.81-.34-1.63-.66-2.43-1v-6.6c1.36-.78 2.72-1.48 4.09-2.28v-.65zm-58.56.2l5.83 3.32c-.23.1-.46.2-.69.31-.07 1.48-.08 2.96-.08 4.4l-6.29 2.89c-.96-.57-1.93-1.14-2.9-1.7.04-2.18.08-4.37.08-6.6 1.36-.5 2.68-1.08 4.02-1.59l.03-1.05zm-9.22.31c1.22.73 2.5 1.53 3.78 2.27-.03 2.19-.04 4.37-.08 6.53-1.17.47-2.31.89-3.48 1.35l-5.94-3.51c.07-.02.13-.09.2-.11 0-1.39-.08-2.78 0-4.17l5.52-2.35zm220.78.19c.97.12 1.96.29 2.97.42.03 2.34.08 4.69.11 7.03-.76.7-1.5 1.41-2.24 2.12l-6.95-.93c-.01-.83-.03-1.71 0-2.58l6.1-6.06zm-265.17.27v.15c1.42 1.01 2.9 2 4.32 3.01-.04 2.13-.06 4.28-.08 6.41-1.23.39-2.47.77-3.7 1.12L297 586c.04-1.26.08-2.58.2-3.86-.33-.23-.7-.46-1.04-.69l6.06-1.97zm-34.51.42l5.17 4.06c-.04.99-.06 1.95-.08 2.93l-7.53 1.97c-.54-.44-1.09-.84-1.63-1.28.04-2.25.1-4.5.16-6.75 1.33-.27 2.62-.57 3.9-.93zm137.49 0c1.23.55 2.53 1.15 3.86 1.74.01 2.19.03 4.37 0 6.53-.68.35-1.33.66-1.97 1.01l-7.41-3.4c0-.89.01-1.76.04-2.66l5.48-3.2zm-20.15.04l5.4 2.67c-.06.03-.14.09-.2.12-.12 1.47-.09 2.94-.03 4.36l-5.41 2.98-3.78-1.97v-5.52c1.33-.7 2.7-1.41 4.02-2.16.01-.15 0-.31 0-.46zm-9.03.74c1.23.66 2.5 1.31 3.82 1.97v5.68l-2.24 1.2-7.26-3.86c0-.71.01-1.42.04-2.13l5.63-2.86zm82.06 0l5.9 1.97c-.15.1-.31.25-.46.35v4.32l-5.44 3.9c-1.28-.43-2.5-.88-3.75-1.31-.03-2.23-.04-4.42-.04-6.68 1.25-.83 2.47-1.68 3.78-2.54zm-213.49.16c1.06.88 2.15 1.77 3.2 2.66-.05 2.15-.08 4.28-.12 6.41-1.51.26-2.93.6-4.4.85v.04l-5.09-4.4c0-1.43.05-2.9.11-4.29l6.29-1.27zm248.69.42c1.19.29 2.4.57 3.67.85.02 2.25.03 4.51.07 6.75-.69.54-1.36 1.1-2.05 1.62l-7.22-2c-.02-.92-.04-1.82-.04-2.74l5.56-4.48zm23.78.15l5.9 1.24c-.23.18-.43.4-.66.58-.07 1.68-.07 3.28 0 4.86v.03l-5.14 4.4v-.19c-1.41-.29-2.81-.5-4.17-.77-.04-2.22-.07-4.42-.11-6.64 1.39-1.15 2.71-2.32 4.17-3.52zm-206.28.43l6.06 4.13v3.59l-5.99 2.12c-1.07-.73-2.12-1.44-3.13-2.17.02-2.28.11-4.59.12-6.87.98-.27 1.95-.52 2.93-.81zm139.76.92c.81.27 1.61.54 2.4.81.01 2.21.01 4.38 0 6.56-.79.53-1.59 1.09-2.4 1.62l-6.91-2.47c.01-.58.03-1.19.04-1.77l6.87-4.75zm-199.71.5l7.18 6.06c-.01.49.01.98 0 1.47l-6.83 1.51c-.75-.63-1.57-1.22-2.31-1.81.07-2.29.12-4.59.19-6.91.59-.1 1.19-.19 1.78-.3zm-21.42.54c1.15 1.03 2.33 2.07 3.52 3.09-.06 2.2-.1 4.38-.08 6.56-1.29.18-2.56.39-3.9.58l-5.9-5.21c.09-.02.22-.03.31-.04 0-1.27.04-2.58.11-3.86l5.95-1.12zm58.13.23c0 .29.02.61 0 .89 1.36 1.02 2.72 1.97 4.17 2.97-.02 2.19-.04 4.38-.04 6.57-.88.26-1.75.5-2.62.74l-6.78-5.15c-.01-1.3-.04-2.58.08-3.94-.19-.14-.35-.29-.54-.43l5.75-1.66zm220.56.39c1.16.32 2.38.56 3.63.85.03 2.23.05 4.47.08 6.68-.83.7-1.66 1.35-2.51 2.01l-6.91-1.66v-2.89c-.07-.02-.16-.02-.23-.04l5.94-4.94zm23.7 0l5.44 1c-.1.09-.17.19-.27.27v2.78l-7.84 7.06c-.46-.09-.96-.22-1.39-.31-.05-2.21-.06-4.43-.08-6.64 1.36-1.15 2.72-2.29 4.13-3.44-.01-.24.01-.49 0-.73zm-102.87.35l6.1 2.4c-.29.18-.6.37-.89.54v4.79l-5.19 3.44v-.31c-1.39-.52-2.72-1.07-4.05-1.62v-6.64c1.33-.78 2.67-1.56 4.01-2.43v-.16zm-96.54.23c1.08.73 2.25 1.45 3.4 2.12 0 2.15-.01 4.28-.04 6.41-1.22.46-2.44.92-3.63 1.35l-5.72-3.74c.02-1.26.06-2.52.12-3.82v-.07l5.86-2.24zm140.58.5l5.33 1.58-.04.04c-.07 1.58-.08 3.1-.08 4.63l-6.06 4.67c-1.1-.3-2.2-.63-3.24-.96-.03-2.2-.02-4.39 0-6.56 1.34-.99 2.76-1.98 4.09-2.93v-.46zm-53.11.46c1.23.58 2.51 1.15 3.78 1.66.01 2.16.06 4.33.07 6.48-.81.5-1.62.97-2.43 1.43l-6.91-3.05c0-.9 0-1.8.03-2.7l-.46-.19 5.9-3.63zm-80.17.15l6.49 3.94v3.36l-5.68 2.47c-1.19-.73-2.37-1.43-3.51-2.12.01-2.23.06-4.42.07-6.68.86-.32 1.75-.64 2.62-.96zm-124.67.12c1.06.95 2.13 1.89 3.16 2.85-.08 2.19-.19 4.37-.24 6.56-1.35.11-2.68.29-3.94.43l-5.44-5.25c.02-1.24.06-2.47.12-3.7l6.33-.89zm22.04.54l6.18 5.4c-.02.63-.02 1.22-.04 1.85l-7.41 1.5c-.56-.49-1.17-.94-1.74-1.42.01-2.28.05-4.62.11-6.95.97-.11 1.93-.23 2.9-.38zm133.28.11l6.02 3.25-.46.23v4.25l-6.29 3.13c-1.1-.58-2.13-1.21-3.17-1.81v-6.67c1.29-.58 2.58-1.19 3.89-1.77v-.58zm176.24.08l6.14 1.01c-.3.28-.62.58-.92.85-.06 1.41-.01 2.74 0 4.09l-5.63 5.29c-1.23-.2-2.43-.38-3.63-.58-.05-2.27-.03-4.56-.08-6.83 1.34-1.19 2.68-2.4 4.13-3.67v-.15zm-146.9.04l6.06 2.9c-.3.16-.63.31-.93.47 0 1.1.02 2.22.04 3.32l-6.37 3.67c-.98-.46-1.93-.94-2.89-1.43v-6.56c1.36-.69 2.71-1.37 4.09-2.12V587zm-103.14.08l5.64 4.06c-.07 1.19-.08 2.4-.08 3.55l-6.37 2.09c-.93-.66-1.89-1.36-2.82-2.04.05-2.2.15-4.41.2-6.64 1.15-.32 2.29-.65 3.43-1zm65.31.5c1.08.6 2.14 1.2 3.28 1.82v6.29c-1.37.64-2.77 1.28-4.09 1.89l-5.4-3.01.15-4.17 6.06-2.82zm29.18.27c1.07.56 2.2 1.05 3.32 1.59v6.6c-.68.34-1.39.66-2.08 1l-6.83-3.43v-2.74l5.59-3.01zm73.23.2c1.09.36 2.19.72 3.36 1.08 0 2.23 0 4.5.04 6.72-.56.39-1.12.71-1.66 1.08l-7.65-2.51v-2.16l5.91-4.21zm-191.49.15v.15c1.37 1.18 2.88 2.31 4.32 3.44-.04 2.14-.11 4.29-.16 6.41-1.5.31-2.96.66-4.4 1.01v.46l-5.25-4.13c.07-.02.15-.05.23-.07 0-1.53-.03-3.12.04-4.71-.36-.27-.69-.6-1.04-.88l6.25-1.66zm250.66.15c.95.2 1.92.4 2.9.58.03 2.29.11 4.58.19 6.87-.61.51-1.18 1.01-1.78 1.51l-7.49-1.58c-.02-.63-.08-1.28-.08-1.93l6.25-5.44zm-301.6.65l6.56 5.87c-.01.67-.02 1.33-.04 2.01l-6.91 1.31c-.72-.63-1.44-1.26-2.12-1.89.05-2.33.15-4.66.23-6.99.77-.09 1.51-.19 2.27-.31zm339.48.27l5.67.69c-.14.13-.29.25-.43.38 0 1.18.05 2.36.07 3.51l-7.33 7.1c-.68-.1-1.34-.18-2.01-.27-.04-2.27-.11-4.53-.15-6.8 1.37-1.26 2.81-2.56 4.17-3.86v-.77zm-73.5.5l5.98 1.58c-.27.2-.55.41-.81.62-.05 1.16-.07 2.38-.04 3.55l-6.06 4.87c-1.08-.3-2.14-.63-3.21-.97-.01-2.2-.06-4.42-.07-6.64l4.2-3.01zm49.17 0v.81c1.39.24 2.75.49 4.13.7.04 2.33.07 4.64.11 6.95-.71.63-1.45 1.22-2.16 1.85l-7.02-1.31c-.01-.88-.04-1.75 0-2.66-.55-.11-1.1-.2-1.66-.3l6.6-6.03zm-93.33.62l5.67 2.13c-.15.11-.31.21-.46.31-.07 1.59-.04 3.21-.04 4.75l-5.9 4.13c-1.1-.42-2.2-.85-3.28-1.27 0-2.2-.02-4.38-.04-6.56 1.35-.82 2.72-1.7 4.05-2.55v-.93zm-126.49.35c1.24.87 2.56 1.76 3.9 2.63-.04 2.13-.07 4.25-.08 6.41-1.02.33-2.04.66-3.05.97l-6.37-4.32c.05-1.23.06-2.47.16-3.74l5.44-1.93zm-35.32.23l5.95 4.56c-.15.05-.32.08-.47.12 0 1.24-.01 2.47-.04 3.71l-5.9 1.7c-1.08-.81-2.12-1.56-3.17-2.35.02-2.31.06-4.62.12-6.91 1.17-.28 2.3-.53 3.51-.81zm294.36.08l6.33.74-1.08 1.04c-.07 1.68.01 3.33-.04 4.9l-5.1 5.14c-1.41-.19-2.85-.43-4.21-.62-.07-2.29-.14-4.56-.15-6.87 1.37-1.29 2.74-2.58 4.24-3.9 0-.13.01-.29 0-.42zm-368.19.62l5.56 5.33c-.04.85-.02 1.67-.04 2.51l-7.41 1.12c-.59-.54-1.17-1.12-1.74-1.66.09-2.29.16-4.63.23-6.95 1.12-.1 2.25-.21 3.4-.35zm49.98.31v.77c1.34 1.15 2.8 2.29 4.21 3.44-.04 2.15-.07 4.33-.08 6.49-1.29.26-2.57.48-3.82.74l-5.87-4.86c.06-.01.1-.03.16-.04 0-1.59.1-3.23.24-4.82-.17-.14-.33-.28-.5-.42l5.68-1.27zm68 .07l5.56 3.75c-.08 1.28-.06 2.57-.12 3.82l-6.09 2.32c-1.06-.68-2.11-1.35-3.09-2.01.03-2.19.05-4.39.07-6.6 1.18-.4 2.41-.83 3.67-1.27zm153.43.16c1.01.3 2.03.64 3.05.93.01 2.2.03 4.38.08 6.56-.75.56-1.51 1.11-2.28 1.66l-7.11-2.13V597l6.26-4.785zm-43.93.7c.93.36 1.9.7 2.89 1.04v6.71c-.56.34-1.11.66-1.66 1l-7.74-2.98c0-.51.03-1.06.04-1.58l6.44-4.2zm66.97.66l5.64 1.35c-.09.07-.22.13-.31.19-.07 1.64 0 3.31 0 4.9.13.04.29.08.42.12L502.3 605l-3.74-.93c-.07-2.22-.16-4.42-.19-6.64 1.39-1.05 2.75-2.14 4.09-3.24v-.66zm-88 .19l5.59 2.47c-.12.06-.26.12-.38.19-.14 1.64-.04 3.21-.04 4.75.18.09.35.18.54.27l-5.91 3.63c-1.34-.56-2.62-1.14-3.9-1.66v-6.6c1.34-.78 2.77-1.48 4.09-2.28v-.77zm137.99 0c1.15.2 2.32.43 3.51.57.04 2.35.1 4.68.15 7.02-.51.46-1.07.9-1.59 1.35l-7.68-1.31c-.02-.79-.07-1.6-.08-2.4l5.68-5.25zm-205.66.77c1.15.67 2.4 1.34 3.63 2.04-.02 2.17-.04 4.36-.04 6.56-1.04.4-2.07.8-3.09 1.19l-6.37-3.9c0-1.13.01-2.28.08-3.44l5.79-2.47zm38.72.31l5.6 2.78c-.27.13-.55.29-.81.42-.06 1.32-.08 2.6-.08 3.9l-6.84 3.63c-.77-.37-1.52-.76-2.27-1.16v-6.87l4.4-2.28v-.43zm19.49.04c0 .07-.01.15 0 .23 1.3.58 2.67 1.26 4.13 1.89v6.56c-.46.23-.94.46-1.39.69l-7.99-3.67c0-.9 0-1.83.04-2.74l5.21-2.97zm161.69.3v.15c1.28.24 2.68.36 4.05.54.03 2.32.09 4.64.15 6.95-.69.67-1.39 1.32-2.09 1.97l-7.14-1.01c-.01-.84-.04-1.69-.04-2.55-.35-.05-.66-.07-1.01-.11l6.06-5.95zm-323.5.11c-.01.08 0 .16 0 .23 1.43 1.17 2.89 2.3 4.32 3.51-.07 2.17-.15 4.35-.19 6.49-1.42.24-2.8.49-4.17.77l-5.29-4.56c.01-1.43.09-2.87.15-4.25-.34-.3-.67-.62-1.01-.93l6.17-1.27zm111.86.04l5.86 3.36c-.06 1.13-.12 2.28-.12 3.39l-6.33 2.86c-.96-.57-1.93-1.2-2.86-1.77.04-2.16.09-4.35.11-6.52l3.32-1.31zm21.49.23l3.36 1.74v6.98c-1.23.59-2.44 1.13-3.63 1.66l-5.98-3.24v-.04c0-1.38.06-2.68.16-4.01l6.1-3.09zm-73.49.08c1.11.83 2.18 1.7 3.32 2.51-.03 2.13-.06 4.24-.12 6.33-1.05.34-2.09.67-3.16.96l-6.21-4.52c.03-1.1.03-2.22.08-3.32l6.1-1.97zm155.36 0l6.29 2.16c-.34.22-.66.47-1.01.69-.06 1.41-.07 2.83-.07 4.21l-5.4 3.86c-1.25-.39-2.5-.8-3.74-1.2-.01-2.23-.06-4.43-.07-6.68 1.33-.89 2.61-1.82 4.01-2.78v-.27zm-192.3.15l6.45 5.09c-.02.91-.07 1.79-.11 2.7l-6.33 1.66c-.92-.72-1.82-1.43-2.74-2.17.05-2.27.11-4.5.16-6.76.86-.16 1.74-.34 2.59-.54zm227 .96c1.32.37 2.64.68 4.01 1 .02 2.26.07 4.53.12 6.79l-1.46 1.12-7.87-2.09c0-.89 0-1.78-.04-2.66l5.25-4.17zm24.2.31l6.13 1.27c-.29.23-.6.46-.89.69-.03.77-.04 1.57-.04 2.31l-7.37 6.33c-.61-.12-1.25-.25-1.85-.39-.04-2.24-.07-4.44-.12-6.68 1.38-1.1 2.75-2.22 4.13-3.4v-.2zm-206.51.04l5.9 4.09c0 1.34-.03 2.67-.08 4.01l-5.56 2c-1.16-.82-2.35-1.62-3.51-2.44.03-2.21.08-4.45.12-6.72 1.04-.31 2.08-.63 3.13-.96zm139.34.89c.95.35 1.89.71 2.86 1.04l.04 6.56c-.71.46-1.38.92-2.08 1.39l-7.18-2.59c.01-.68.02-1.34.04-2.01l6.33-4.4zm-221.79.19v.73c1.34 1.19 2.82 2.41 4.29 3.66-.05 2.21-.11 4.39-.2 6.6-1.1.16-2.21.31-3.28.47L223 604.2c-.05-1.52.02-3.04.16-4.56-.14-.12-.28-.26-.42-.38l5.6-1.08zm22.78.54l6.6 5.52c-.01 1.04-.02 2.08-.04 3.12l-5.83 1.31c-1.1-.88-2.17-1.74-3.24-2.63.04-2.31.05-4.58.12-6.91.8-.15 1.59-.28 2.39-.43zM429 599.9l6.37 2.544c-.35.217-.69.404-1.044.62-.062 1.45-.114 2.875-.114 4.283l-5.326 3.43c-1.323-.5-2.583-.977-3.82-1.508-.014-2.18-.065-4.36-.077-6.56 1.31-.81 2.64-1.67 4.01-2.51v-.31zm103.096.15l5.676 1.08c-.15.137-.31.29-.46.425-.07 1.584-.007 3.177 0 4.71l-6.06 5.48c-1.09-.212-2.147-.436-3.206-.655-.037-2.246-.05-4.51-.076-6.757 1.35-1.13 2.73-2.244 4.13-3.434 0-.29.004-.56 0-.85zm-199.63.31c1.08.7 2.216 1.35 3.28 1.967 0 2.18-.047 4.345-.077 6.482-1.17.44-2.31.85-3.52 1.24l-5.87-3.86c.02-1.17.02-2.34.07-3.48l6.1-2.36zm176.936 0c.835.205 1.65.377 2.51.578.015 2.24.073 4.48.116 6.72-.657.54-1.3 1.1-1.97 1.62l-7.41-1.89c-.008-.48.03-.95.04-1.43l6.712-5.596zm-36.24.076l5.79 1.693c-.19.13-.35.29-.54.42-.066 1.47-.08 2.92-.08 4.36l-6.448 4.82c-.935-.28-1.896-.53-2.815-.81-.04-2.17-.05-4.34-.08-6.53 1.41-1 2.82-2 4.17-3.01v-.97zm-185.547.072c1.3.915 2.63 1.824 3.934 2.78-.02 2.17-.07 4.35-.12 6.52-1.4.4-2.74.79-4.09 1.156l-5.99-4.56c.21-.05.45-.102.65-.153.06-1.41.09-2.8.15-4.21l5.44-1.54zm52.53.58l5.942 3.63v3.82l-5.71 2.47c-1.19-.67-2.35-1.3-3.436-1.97 0-2.23.05-4.48.08-6.756 1.05-.38 2.08-.76 3.13-1.193zm59.594.58l6.79 3.123c-.55.3-1.12.59-1.66.89v4.75l-5.14 2.87c0-.06-.01-.09 0-.15-1.33-.66-2.74-1.28-4.09-1.93v-6.64c1.32-.67 2.65-1.34 4.09-2.04v-.85zm21.57.23c.81.36 1.64.72 2.47 1.04-.02 2.186-.01 4.36 0 6.526-.61.365-1.21.697-1.81 1.04l-7.49-3.28c0-.382-.01-.737 0-1.12l6.83-4.206zm-124.48.19l5.17 3.665c-.06 1.5-.05 3.018-.11 4.517l-6.1 2.01c-1.02-.73-2.05-1.45-3.05-2.16 0-2.237.11-4.44.15-6.678 1.32-.363 2.61-.75 3.94-1.156v-.196zm-59.83.23l5.87 5.095c-.03 1.1-.04 2.22-.08 3.32L236.4 612c-.93-.805-1.83-1.61-2.74-2.392.05-2.266.13-4.544.194-6.83 1.023-.135 2.07-.284 3.124-.464zm285.52.23c0 .46.04.924.04 1.39 1.31.234 2.68.49 4.05.77.04 2.33.11 4.657.11 6.987-.43.34-.89.66-1.32 1l-7.95-1.7c-.02-.968-.04-1.92-.04-2.897-.36-.07-.66-.164-1-.23l6.1-5.33zm-160.84.193c1.11.64 2.3 1.21 3.47 1.82-.02 2.13-.06 4.28-.08 6.41-1.18.53-2.37 1.02-3.55 1.55l-5.91-3.43c.03-1.16.02-2.29.07-3.47-.02-.01-.02-.07-.04-.08l6.02-2.78zm185.19 0l6.29 1.08c-.35.31-.72.63-1.08.93-.04 1.03-.05 2.05-.04 3.05l-6.63 6.14c-.86-.13-1.72-.23-2.55-.35-.06-2.29-.08-4.6-.11-6.91 1.36-1.19 2.69-2.41 4.13-3.63v-.31zm-156.32.08c1.21.66 2.52 1.3 3.86 1.89v6.49c-1.27.66-2.53 1.31-3.86 1.93l-5.56-2.77c0-1.34.05-2.71.11-4.09-.15-.07-.31-.12-.46-.19l5.9-3.24zm-21.46.39l6.41 3.48v2.9l-6.29 3.09c-.97-.49-1.93-.99-2.86-1.5.02-2.23.01-4.48.04-6.72.91-.39 1.79-.81 2.7-1.23zm95.23.23c1.13.4 2.3.76 3.43 1.12.03 2.24.04 4.47.08 6.68-.49.36-.97.7-1.47 1.04l-7.84-2.62v-2.08l5.79-4.13zm-242.36 1.08l6.13 5.45c-.01 1.25-.03 2.49-.08 3.75l-5.87 1.08c-1.06-.92-2.12-1.85-3.21-2.78.03-2.34.1-4.69.2-7.06.92-.14 1.87-.27 2.82-.43zm51.49.04c1.09.85 2.22 1.73 3.39 2.59-.03 2.13-.05 4.26-.08 6.37-1.5.35-2.94.75-4.44 1.04l-5.1-4.05c.01-1.42.05-2.87.12-4.28l6.1-1.66zm214.45.16l6.68 1.78c-.46.35-.93.74-1.39 1.08-.07 1.58-.01 3.1 0 4.63l-5.14 3.98c-1.48-.43-2.81-.81-4.17-1.23-.01-2.21-.1-4.4-.12-6.64 1.38-.98 2.75-1.94 4.13-2.93v-.65zm73.8.42l5.87.77c-.21.2-.41.39-.62.58-.07 1.7 0 3.41 0 5.06.12.02.23.02.35.04l-5.91 5.75c-1.26-.17-2.53-.37-3.79-.58-.05-2.26-.08-4.49-.12-6.75 1.42-1.26 2.84-2.51 4.21-3.82-.02-.35.01-.69 0-1.04zm-117.96.5l5.9 2.16c-.22.14-.4.25-.62.39-.07 1.56-.05 3.15-.12 4.71l-6.02 4.09c-1.05-.43-2.12-.81-3.13-1.19-.04-2.17-.04-4.42-.04-6.64 1.39-.8 2.7-1.63 4.01-2.47v-1.04zm-126.53.5c1.26.82 2.53 1.65 3.74 2.43-.04 2.15-.11 4.31-.12 6.45-.86.28-1.67.54-2.51.81l-6.91-4.63c.02-.97.08-1.95.12-2.93l5.68-2.12zm221.06.5c1.03.23 2.11.43 3.16.58.03 2.29.05 4.62.12 6.91-.56.49-1.12.98-1.7 1.47l-7.65-1.46v-2.01l6.06-5.48zm-258.15.08l7.06 5.44c-.02.96-.07 1.93-.08 2.86l-5.68 1.7c-1.23-.89-2.36-1.83-3.48-2.74.05-2.24.11-4.52.16-6.79.68-.16 1.34-.3 2.01-.46zm45.97.62l5.33 3.48c-.05 1.3-.12 2.6-.12 3.9l-6.22 2.43c-1-.64-2.05-1.28-3.01-1.93 0-2.18.02-4.39.04-6.6 1.32-.4 2.66-.78 3.97-1.27zm152.97.31c1.17.33 2.33.68 3.47 1.01.01 2.22.03 4.44.08 6.64-.67.49-1.32.99-2.01 1.47l-7.33-2.27c-.01-.78.01-1.53.04-2.32-.05-.01-.11-.03-.16-.04l5.91-4.48zm-44.01.47c1.09.45 2.24.85 3.36 1.24.01 2.23 0 4.47.04 6.68-.4.23-.81.46-1.2.7l-8.22-3.2c.01-.52.02-1.02.04-1.54l5.99-3.86zm-20.61.31l6.33 2.78c-.36.21-.75.38-1.12.58-.07 1.59-.04 3.18-.04 4.71.01.01.02.03.03.04l-5.9 3.59c-1.19-.51-2.4-1.04-3.59-1.54v-6.33c1.43-.81 2.84-1.56 4.28-2.39v-1.42zm-156.36.12c1.23 1.05 2.52 2.08 3.89 3.13-.05 2.17-.11 4.3-.16 6.45-1.25.25-2.49.49-3.67.73l-5.94-4.9c.03-.01.05 0 .08 0 .06-1.37.1-2.71.19-4.09l5.59-1.31zm244.56.08l6.21 1.58c-.3.24-.62.42-.93.66-.04.92-.03 1.89-.04 2.82l-7.9 6.38c-.49-.12-.99-.19-1.47-.31v-6.64c1.36-1.07 2.8-2.11 4.13-3.2 0-.42-.04-.84-.04-1.27zm49.44.54v.58c1.36.23 2.73.52 4.09.69.03 2.36.07 4.71.12 7.07-.31.27-.62.53-.93.81l-8.26-1.43c-.02-.9-.04-1.78-.04-2.7l-.39-.03 5.4-4.98zm-205.66.46c1.23.73 2.57 1.52 3.86 2.28-.02 2.17-.04 4.31-.04 6.45-.93.39-1.9.77-2.86 1.16l-6.53-3.97c0-1.16 0-2.35.08-3.51l5.48-2.39zm9.03.7l5.32 3.09c-.04 1.12-.05 2.25-.08 3.35l-6.79 3.13c-.79-.46-1.57-.93-2.35-1.39-.04-2.19-.06-4.37-.04-6.56 1.36-.51 2.66-1.07 3.94-1.62zm20.03.5v.15c1.35.72 2.79 1.5 4.17 2.16-.02 2.15.01 4.27.04 6.45-.97.48-1.87.96-2.82 1.39l-6.64-3.59c.01-1.34.09-2.67.15-4.01l5.1-2.55zm83.03.12l6.56 2.24c-.44.29-.92.6-1.35.88-.06 1.2-.02 2.34 0 3.51l-6.06 4.29c-1.06-.35-2.11-.7-3.17-1.08-.03-2.23-.04-4.43-.04-6.64 1.35-.87 2.7-1.78 4.05-2.7v-.5zm-52.34.03c.91.42 1.82.83 2.74 1.24v6.64c-1.06.54-2.09 1.12-3.12 1.66l-6.14-2.82c0-.97.02-2.01.04-3.01l6.48-3.71zm-139.88.2l5.91 4.71c-.02 1.13-.01 2.24.04 3.36l-6.22 1.7c-1.01-.78-2.04-1.59-3.01-2.39.08-2.22.14-4.5.19-6.75 1.02-.21 2.06-.38 3.08-.62zm36.63.04c1.06.75 2.15 1.45 3.28 2.2-.03 2.13-.08 4.26-.11 6.37-1.48.44-2.89.91-4.33 1.35-.01.13.01.23 0 .35l-5.17-3.7c0-1.51.08-3.04.15-4.55l6.17-2zm81.48.08l5.79 2.94c-.04 1.28-.08 2.58-.08 3.82.22.12.44.2.66.31l-5.99 3.17c-1.32-.67-2.63-1.3-3.9-1.93 0-2.16.02-4.3.04-6.49 1.16-.57 2.3-1.21 3.47-1.81zm-140.73.5c1.15.96 2.33 1.93 3.47 2.86-.06 2.15-.13 4.32-.16 6.45-1.28.25-2.48.44-3.71.66l-5.83-4.86c.02-1.25.12-2.49.23-3.74v-.04l5.98-1.31zm273.86.43l6.67 1.51c-.5.4-1.01.79-1.51 1.2v4.25l-5.52 4.71c-1.26-.26-2.5-.52-3.71-.77-.06-2.24-.12-4.49-.15-6.75 1.38-1.1 2.76-2.22 4.21-3.36-.01-.25.01-.51 0-.77zm-206.31.04l5.86 4.02c-.17.05-.37.1-.54.15 0 1.3-.06 2.6-.08 3.86l-5.87 2.16c-1.08-.72-2.12-1.48-3.17-2.23.01-2.26.03-4.52.08-6.79 1.23-.37 2.5-.73 3.7-1.16zm183.11.43c.95.24 1.89.48 2.89.73.02 2.27.04 4.52.04 6.79-.42.33-.82.65-1.24.97l-7.99-2.2v-1.27l6.29-5.02zm-44.27.73c.99.34 1.98.66 2.97 1.01.01 2.19.1 4.4.12 6.6-.56.37-1.11.72-1.66 1.08l-7.72-2.86c0-.53.02-1.08.04-1.62l6.25-4.21zm-199.13.81l6.52 5.48c-.01 1.18-.07 2.33-.11 3.48l-5.68 1.31c-1.13-.95-2.27-1.89-3.4-2.82.03-2.3.1-4.63.16-6.95.85-.15 1.68-.33 2.51-.5zm178.09.43l6.98 2.82c-.58.35-1.13.68-1.7 1.01-.06 1.45-.01 2.82 0 4.17l-5.56 3.59c-1.23-.5-2.44-.98-3.59-1.51v-6.76c1.28-.73 2.6-1.51 3.86-2.32-.02-.34 0-.67 0-1zm78.85.39v.73c1.42.39 2.78.7 4.24 1 0 2.25.05 4.46.11 6.68-.61.47-1.24.93-1.85 1.39l-7.53-1.89c.01-.73-.04-1.46 0-2.2-.54-.13-1.08-.22-1.62-.35l6.63-5.37zm24.31.39l6.33 1.24c-.36.3-.71.6-1.08.89 0 1.19.01 2.3.04 3.44l-7.26 6.49c-.69-.14-1.36-.3-2.01-.46-.01-2.25-.08-4.5-.12-6.75 1.35-1.1 2.75-2.17 4.09-3.32v-1.49zm-199.98.23c1.11.71 2.25 1.44 3.36 2.12-.03 2.1-.05 4.2-.08 6.29-1 .41-1.99.8-2.97 1.16l-6.45-4.21c.02-1 .04-2 .08-2.97l6.06-2.39zm141.08.19l5.9 1.78c-.26.18-.52.41-.78.58-.07 1.65 0 3.24 0 4.83.18.06.37.06.54.12l-5.98 4.52c-1.35-.39-2.68-.77-3.93-1.19v-6.56c1.37-.96 2.85-1.95 4.25-2.9 0-.37.01-.78 0-1.16zm-185.7.27c1.19.89 2.39 1.74 3.63 2.59-.03 2.21-.07 4.42-.16 6.61-1.13.34-2.28.66-3.4.97l-5.94-4.52c0-1.33.01-2.62.11-3.93l5.75-1.7zm52.57.31l6.02 3.59c-.08.03-.15.08-.23.12 0 1.11-.02 2.17-.04 3.24l-6.3 2.7c-.96-.56-1.93-1.14-2.9-1.73l.04-6.64c1.11-.41 2.25-.85 3.4-1.27zm80.71.66c1.01.45 2.04.84 3.09 1.24v6.33c-1.3.79-2.61 1.56-3.86 2.28l-5.68-2.51V621l6.44-3.935zm-59.83.74c1.29.68 2.69 1.4 3.98 2.12 0 2.13-.09 4.24-.12 6.37-1.1.5-2.18 1-3.28 1.5l-6.14-3.47c.03-1.14.06-2.28.11-3.44l-.58-.35 6.02-2.74zm-65.73.31l6.37 4.59c-.04.94-.04 1.87-.08 2.82l-6.37 2.05c-.95-.67-1.88-1.3-2.82-1.97.05-2.25.07-4.5.12-6.75.9-.25 1.85-.48 2.78-.73zm74.34.19l5.91 3.2c-.04.02-.07.06-.11.08 0 1.59-.05 3.14-.12 4.67.05.03.1.05.15.08l-5.25 2.59c0-.11-.01-.2 0-.31-1.44-.7-2.78-1.43-4.09-2.16 0-2.22.08-4.46.11-6.68 1.13-.5 2.26-.96 3.39-1.47zm29.07.04l6.1 2.89c.01 1.36.03 2.73.04 4.05l-5.44 3.09c-1.32-.57-2.6-1.2-3.78-1.81 0-2.2.08-4.41.11-6.64 1.01-.5 1.99-1.05 2.97-1.58zm65.16.42c1.3.46 2.59.96 3.94 1.39.02 2.23.07 4.43.07 6.64-.28.2-.6.42-.89.62l-8.42-2.82v-2.05l5.29-3.78zm-71.91.19c.76.37 1.55.78 2.35 1.16 0 2.16.05 4.29.08 6.45-1.14.54-2.25 1.06-3.32 1.62l-6.1-3.04c0-.83.09-1.64.11-2.47l6.87-3.7zm131.27.97c1.18.24 2.37.48 3.55.7.04 2.36.07 4.71.08 7.02-.35.28-.69.53-1.05.81l-8.14-1.78c-.01-.64-.04-1.28-.04-1.93l5.6-4.82zm-249.85.27c1.04.82 2.07 1.65 3.13 2.47-.06 2.16-.13 4.31-.15 6.45-1.41.33-2.81.6-4.21.93l-5.29-4.17c.01-1.29.09-2.62.15-3.94l6.37-1.74zm214.65.08l6.64 1.89c-.46.35-.89.69-1.36 1.04v2.16l-7.45 5.79c-.62-.18-1.27-.35-1.89-.54-.03-2.22-.07-4.46-.08-6.71 1.37-.97 2.69-1.95 4.13-2.94v-.69zm-44.28.23l6.52 2.36c-.41.27-.83.55-1.24.81-.06 1.43-.04 2.77-.04 4.13l-6.64 4.48c-.9-.34-1.79-.66-2.67-1v-6.71c1.33-.79 2.71-1.58 4.09-2.47 0-.51-.03-1.06-.04-1.58zm-126.84.93c1.23.83 2.43 1.67 3.7 2.51-.02 2.16-.04 4.32-.08 6.48-1.33.43-2.6.87-3.9 1.31l-5.94-3.98c.15-.05.28-.07.43-.12 0-1.39-.02-2.75.07-4.13l5.71-2.08zm-36.63.69l6.56 4.94c-.01 1.09-.07 2.2-.12 3.28l-5.79 1.69c-1.13-.81-2.23-1.61-3.32-2.43.01-2.31.08-4.62.15-6.91.82-.2 1.69-.37 2.51-.57zm45.74.08l5.21 3.51c-.12 1.34-.12 2.61-.12 3.9l-6.87 2.63c-.79-.52-1.6-1.05-2.4-1.55.05-2.22.09-4.44.11-6.68 1.34-.44 2.72-.89 4.05-1.39.01-.13 0-.29 0-.42zm108.95 1.2c1.15.48 2.28.94 3.51 1.35v6.64c-1.03.58-2.03 1.18-3.01 1.78l-6.45-2.55c0-1.13.04-2.28.08-3.43l5.87-3.78zm45.27.08c.81.25 1.61.5 2.43.73 0 2.25.01 4.44.07 6.68-.51.36-1 .72-1.51 1.08l-7.88-2.4c0-.32.03-.64.04-.97l6.83-5.13zm-222.33.77c1.21.96 2.41 1.94 3.66 2.93-.04 2.18-.07 4.34-.12 6.53-1.05.22-2.07.44-3.09.66l-6.41-5.24c.04-1.14.04-2.29.08-3.43l5.87-1.43zm244.9.04l6.13 1.55c-.31.24-.58.5-.89.74v4.75l-6.02 4.9c-1.12-.27-2.24-.51-3.28-.81-.04-2.18-.09-4.36-.12-6.56 1.37-1.08 2.82-2.1 4.17-3.2v-1.35zm-156.95.39v.07c1.42.78 2.8 1.57 4.21 2.39-.02 2.19-.04 4.38-.08 6.53-.75.31-1.51.64-2.24.93l-7.14-4.4c0-1.11.02-2.18.11-3.32l5.14-2.2zm68.09.73l5.63 2.51c-.07 1.28 0 2.57 0 3.86l-6.06 3.66c-1.09-.47-2.16-.96-3.25-1.42.01-2.2.02-4.38.04-6.57 1.18-.68 2.41-1.35 3.63-2.04zm-58.52.08l5.29 3.08c-.06 1.46-.09 2.83-.16 4.25.21.12.44.27.66.39L355.2 636c-1.31-.76-2.63-1.484-3.86-2.24.003-2.196.02-4.377.04-6.56 1.35-.527 2.782-1.065 4.09-1.623v-.074zm103.13.27l7.18 2.47c-.63.42-1.28.81-1.89 1.23-.07 1.58-.04 3.17-.04 4.75v.04l-5.21 3.67v-.27c-1.47-.5-2.89-.97-4.4-1.46v-6.4c1.47-.94 2.87-1.92 4.36-2.89v-1.12zm-52.88.31c1.06.5 2.19 1.04 3.36 1.54-.01 2.19-.04 4.37-.08 6.56-.9.47-1.74.9-2.59 1.35l-6.84-3.2c0-.92.06-1.84.11-2.78l6.02-3.48zm-20.85.23l5.32 2.66c-.11 1.42-.16 2.85-.16 4.36.02.01.02.07.04.08l-5.95 3.17c-1.11-.57-2.26-1.12-3.36-1.66.04-2.16.03-4.34.04-6.52 1.35-.66 2.7-1.36 4.05-2.08zm-82.26.19c1.11.76 2.19 1.55 3.28 2.31-.07 2.13-.1 4.24-.12 6.37-1.34.41-2.72.82-4.09 1.24l-5.37-3.82c.01-1.36.06-2.73.12-4.1l6.18-2.01zm-36.52.15l5.86 4.52c-.02 1.15-.07 2.35-.08 3.48l-6.33 1.73c-1-.75-1.96-1.54-2.9-2.32.09-2.25.14-4.46.19-6.72 1.08-.22 2.15-.42 3.24-.69zm227.23.04v1.3c1.33.38 2.7.76 4.09 1.12.04 2.27.07 4.53.07 6.76-.23.19-.46.36-.69.54l-8.69-2.47c-.01-.71.03-1.42 0-2.12-.37-.11-.69-.2-1.04-.31l6.25-4.82zm-116.69.23c.97.53 1.95 1.03 2.93 1.51-.02 2.17-.07 4.35-.12 6.53-.82.41-1.63.79-2.47 1.16l-6.83-3.59c0-.76.02-1.57.04-2.35l6.44-3.24zm141.1 1.07l6.79 1.51c-.55.43-1.12.89-1.66 1.31v2.89l-6.76 5.79c-.84-.18-1.72-.42-2.55-.61-.04-2.25-.03-4.49-.07-6.71 1.42-1.05 2.8-2.14 4.24-3.32-.01-.27.01-.57 0-.85zm-208.32.15l6.83 4.75c-.03.8-.02 1.58-.04 2.36l-6.33 2.24c-.95-.64-1.92-1.23-2.82-1.85.01-2.26.07-4.5.11-6.79.74-.23 1.49-.47 2.24-.7zm140.15.54c1.13.43 2.26.84 3.39 1.24v6.71c-1.07.72-2.14 1.37-3.2 2.05l-5.98-2.24c0-1.2-.02-2.42.04-3.62-.08-.04-.16-.05-.23-.08l5.98-4.06zm-118.08 1.93c1.24.86 2.54 1.69 3.83 2.47-.04 2.14-.06 4.24-.04 6.33-1.4.58-2.91 1.15-4.28 1.59-.01.06 0 .13 0 .2l-5.21-3.35c0-1.51.05-3.05.11-4.51-.16-.1-.31-.2-.47-.31l6.06-2.39zm141.85.24l6.64 2.01c-.45.32-.95.66-1.39.97-.04.95-.08 1.9-.07 2.82l-7.88 5.87c-.39-.12-.78-.22-1.15-.35v-6.91c1.25-.89 2.52-1.78 3.82-2.66 0-.58.04-1.16.04-1.74zm-186.3.65c1.26.9 2.55 1.77 3.9 2.7-.02 2.19-.07 4.37-.12 6.56-1.14.32-2.27.59-3.35.88l-5.98-4.56c0-1.32-.01-2.66.08-3.98l5.48-1.62zm53.5 0l5.91 3.63c-.22.08-.45.18-.66.27 0 1.59-.01 3.17-.08 4.71l.07.04-5.21 2.28c0-.11-.01-.24 0-.35-1.35-.82-2.69-1.57-4.02-2.35-.02-2.21-.04-4.46 0-6.71 1.36-.49 2.68-.97 3.98-1.5zm87.58.08l6.21 2.47c-.04 1.2-.03 2.37-.03 3.51l-6.02 3.86c-1.09-.41-2.16-.87-3.24-1.31-.03-2.22-.03-4.42 0-6.64 1.06-.62 2.05-1.25 3.09-1.89zm81.06.19c1.02.25 2.07.52 3.12.77.02 2.24.07 4.48.12 6.72-.41.34-.85.68-1.27 1.01l-8.1-2.12c-.01-.46-.04-.92-.04-1.39l6.17-4.97zm-88.39.69c.98.44 1.97.85 2.97 1.27-.01 2.15-.02 4.27 0 6.4-1.05.61-2.09 1.26-3.17 1.85l-6.22-2.82c.01-.98.01-1.96.04-2.94l6.37-3.78zm-125.22.96l5.9 4.2c-.03.95-.08 1.91-.08 2.86l-6.8 2.28c-.83-.59-1.64-1.15-2.43-1.73.04-2.22.07-4.47.11-6.71 1.09-.32 2.19-.58 3.28-.89zm103.6.04l5.9 2.77c-.09.05-.18.11-.27.16v4.13l-5.56 3.13c-1.22-.58-2.42-1.15-3.63-1.73-.02-2.24-.02-4.47 0-6.68 1.17-.61 2.37-1.17 3.55-1.77zm-29.53.07l5.94 3.2c-.11.04-.2.11-.31.15v4.28l-5.41 2.66c-1.22-.69-2.54-1.36-3.78-2.01-.02-2.22-.02-4.43 0-6.68 1.17-.53 2.34-1.1 3.55-1.62zm-7.22.38c.81.45 1.62.89 2.43 1.35-.04 2.15-.06 4.28-.04 6.41-.92.41-1.87.82-2.82 1.23l-6.64-3.79c.01-.63.01-1.22.04-1.86l7.02-3.36zm28.99.07c.96.46 1.93.96 2.89 1.43-.01 2.11-.02 4.22 0 6.37-1.04.55-2.09 1.1-3.17 1.62l-6.25-3.09c0-.96.01-1.91.04-2.86l6.48-3.48zm73.57.65c1.09.38 2.16.76 3.28 1.12v6.41c-1.03.7-2.03 1.38-3.05 2.04l-6.49-2.28c-.01-.95-.02-1.91-.04-2.86l6.29-4.44zm23.16.58l7.41 2.16c-.68.52-1.39 1.06-2.09 1.58-.06 1.3-.04 2.6-.04 3.9l-5.79 4.44c-1.21-.37-2.42-.68-3.63-1.05v-6.64c1.35-1 2.72-1.97 4.13-2.97v-1.43zm-171.92 1.35c1.44.93 2.89 1.85 4.28 2.74-.03 2.14-.08 4.29-.12 6.44-1.14.37-2.25.74-3.4 1.12l-6.06-4.02c0-1.46.09-2.97.15-4.48l5.13-1.82zm127.45 1.08l5.44 2.08c-.05 1.41-.08 2.8-.08 4.13.19.08.38.15.58.23l-6.06 4.01c-1.27-.49-2.6-.98-3.82-1.51V640c1.31-.79 2.67-1.55 3.93-2.353zm34.89.27c0 .23.03.46.04.69 1.34.43 2.69.84 4.17 1.23v6.72c-1.06.75-2.12 1.49-3.21 2.24l-6.06-1.85c-.03-1.19-.02-2.4.04-3.63-.55-.16-1.09-.31-1.63-.47l6.64-4.94zm-153.86.46l6.26 4.05c-.05 1.1-.08 2.22-.08 3.32.22.14.47.24.69.38l-5.98 2.35c-1.33-.82-2.65-1.65-3.94-2.51.04-2.18.05-4.43.07-6.64 1-.31 1.98-.62 2.97-.97zm109.7.27c1.31.55 2.62 1.07 4.02 1.58.02 2.2.04 4.39.04 6.6l-2.47 1.49-6.99-2.78c0-1.12.03-2.26.07-3.4l5.33-3.47zm-79.09 1.66l5.44 3.08c-.06.02-.13.05-.19.08-.07 1.59-.08 3.21-.08 4.74.03.01.05.02.08.04l-6.1 2.81c-1.12-.65-2.2-1.28-3.28-1.93.04-2.18.03-4.42.04-6.64 1.4-.51 2.8-1.03 4.09-1.58v-.61zm58.98.11l5.29 2.31c-.05 1.33-.01 2.64 0 3.97l-6.52 3.9c-.93-.41-1.85-.8-2.78-1.2v-6.52c1.38-.77 2.7-1.51 4.02-2.31v-.15zm-67.16.42c.93.53 1.83 1.11 2.74 1.66-.02 2.17-.04 4.34-.04 6.52-1.25.49-2.46 1.05-3.67 1.54l-6.02-3.63c.11-.04.2-.11.31-.15 0-1 .06-2.06.08-3.09l6.6-2.86zm58.32.58c1.14.49 2.3.98 3.51 1.54v6.56c-.82.41-1.65.81-2.43 1.23l-6.91-3.2v-2.85l5.83-3.28zm-20.61.07l5.17 2.59c-.06 1.54-.04 3.06-.04 4.55l-6.02 3.21c-1.11-.56-2.25-1.09-3.32-1.66.04-2.16.04-4.33.04-6.52 1.36-.69 2.85-1.34 4.17-2.04v-.11zm-8.72.47c1.13.58 2.18 1.19 3.32 1.77-.01 2.17 0 4.34-.04 6.53l-1.97.92-7.41-3.98c0-.75.06-1.47.07-2.2l6.02-3.05zm81.75.96l6.06 2.13c-.04.79-.03 1.52-.04 2.27l-7.45 5.1c-.64-.21-1.24-.4-1.85-.62-.01-2.22-.1-4.49-.12-6.71 1.09-.69 2.24-1.41 3.39-2.16zm-148.15.43l6.29 4.25c0 1.44.02 2.88-.04 4.28.06.04.13.11.19.16l-5.32 1.93c.01-.09-.01-.21 0-.31-1.36-.92-2.67-1.81-4.02-2.7.01-2.24.07-4.48.11-6.75.94-.27 1.85-.56 2.78-.85zm141.08 1.12c.8.29 1.61.61 2.43.89v6.68c-.97.63-1.94 1.2-2.9 1.81l-6.45-2.47c0-.73.03-1.46.03-2.2l6.87-4.71zm-22 2.12l6.02 2.43c-.17.1-.34.18-.51.27-.07 1.59.04 3.17-.03 4.71l.08.04-5.21 3.32c0-.07-.01-.15 0-.23-1.41-.57-2.77-1.09-4.13-1.66v-6.67c1.29-.72 2.54-1.41 3.78-2.2zm-96 .35c.78.49 1.6.92 2.43 1.39-.02 2.15-.11 4.3-.12 6.44-1.32.48-2.59.98-3.86 1.43l-5.64-3.59c.03-.98.05-1.95.08-2.93l7.1-2.74zm5.98.5l6.87 4.13c0 1.17-.07 2.3-.12 3.47l-5.25 2.24c-1.31-.76-2.61-1.48-3.86-2.24 0-2.25.01-4.51.04-6.76.78-.29 1.53-.54 2.31-.85zm81.48.12c1.15.52 2.29 1.01 3.47 1.47v6.56c-.94.54-1.88 1.06-2.82 1.58l-6.56-2.86c.02-1.03.04-2.07.04-3.12-.02-.01-.02-.03-.04-.04l5.91-3.59zm-50.29.93l6.21 3.32c-.3.13-.63.25-.93.38 0 1.37-.03 2.75-.08 4.13l-5.52 2.74c-1.22-.65-2.46-1.33-3.7-2.01 0-2.2.01-4.41.04-6.64l3.97-1.77v-.15zm29.41.19l5.91 2.78-.51.27c0 1.26-.04 2.52-.08 3.71l-5.99 3.32c-1.06-.49-2.13-.96-3.16-1.47v-6.72c1.24-.63 2.53-1.22 3.82-1.89zm-37.56.31c.95.53 1.86 1.08 2.85 1.62-.02 2.15-.03 4.28-.03 6.41-1.39.62-2.79 1.24-4.13 1.85l-5.36-3.05c.05-1.26.11-2.48.16-3.78l6.52-3.05zm29.37.31c1.03.51 2.08 1.04 3.09 1.54-.03 2.13-.08 4.26-.08 6.41-.87.41-1.69.81-2.55 1.23l-6.91-3.4c.02-.79.08-1.61.08-2.39l6.37-3.39z" opacity=".589" fill="url(#n)" transform="translate(-52.625 -80.754)"/><path d="M463.813 190.603c-18.526 6.217-43.84 10.075-71.794 10.075-27.5 0-52.47-3.727-70.91-9.766l-41.42 136.755c27.84 14.57 68.6 23.777 114.06 23.777 44.55 0 84.64-8.817 112.43-22.89l-42.38-137.95z" fill="url(#o)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M321.35 190.513l-.27.772 1.776 1.505c.052.94.038 1.874.038 2.857l-3.203.154-.3 1.05 3.75-.11c1.18.98 2.49 1.99 3.82 2.97l-.15 5.21c-.94.03-1.87.06-2.82.08l-5.63-4.67-.27.85 4.52 3.78c0 1.05-.02 2.14-.08 3.21l-5.48.2c-.29-.25-.59-.52-.89-.77l-1.5 4.83c.9.04 1.81.07 2.66.04l5.6 4.83h-.58c0 .99-.07 1.95-.07 2.94l-5.71.12c-1.05-.89-2.11-1.73-3.13-2.58.01-.45.02-.9.04-1.35l-2.16 7.14c.25.21.49.45.73.66-.1 1.66-.2 3.29-.23 4.95-.7-.01-1.46-.07-2.2-.11l-1.27 4.21c1.09.96 2.19 1.95 3.28 2.98-.05 1.64-.05 3.34-.07 5.02-1.2-.05-2.48-.11-3.63-.2l-1.51-1.39-.27.85 1.16 1.08c-.08 1.03 0 2.04 0 3.13l.66.62-3.24-.19-.27 1 4.71.23c.74.65 1.46 1.31 2.24 1.96-.05 1.73-.06 3.46-.11 5.1-1.1-.09-2.12-.19-3.16-.27l-4.56-4.17-.23.81 3.82 3.47c-.1 1.18-.18 2.42-.23 3.51.03.05.13.11.16.16l-5.75-.42c-.01-.01-.02-.03-.04-.04l-1.23 4.09 1.01.15 5.83 5.48c-.05.89-.07 1.81-.07 2.66.03.03.07.02.12.04l-6.02-.5c-.83-.8-1.67-1.61-2.51-2.39l-1.96 6.45c-.02 1.4-.04 2.79-.08 4.17l.19.15c-.44-.07-.9-.15-1.35-.23l-1.47 4.71c.81.78 1.64 1.55 2.51 2.32-.08 1.66-.14 3.34-.19 5.02.21.09.38.17.54.23-1.02-.15-1.94-.36-2.85-.54l-1.62-1.62-.27.81.62.62c-.15-.04-.28-.11-.46-.15-.1 1.28-.13 2.39-.15 3.7.03 0 .04.06.04.08l-1.23-.23-.31.93 2.78.5c.89.89 1.77 1.78 2.74 2.7-.08 1.02-.2 3.15-.31 5.13-.76-.15-1.45-.23-2.24-.38l-4.09-4.32-.19.74 3.12 3.28c-.23-.07-.49-.05-.73-.12l-.07 1.74-3.59-.7-.31 1 3.9.73-.08.85c1.28 1.4 2.59 2.78 3.98 4.13-.05 1.01-.22 3.12-.35 5.06-1.12-.24-2.23-.47-3.36-.73l-5.4-5.79-.23.77 4.86 5.21-.19 2.9-5.46-1.11c-.34-.37-.66-.75-1-1.12l-1.35 4.48c.41.11.81.21 1.23.31l6.03 6.45c-.03.7-.04 1.41-.04 2.13l-6.6-1.39c-.77-.8-1.48-1.59-2.2-2.39l-1.74 5.79c.62.28 1.23.57 1.85.85.88.25 1.77.48 2.67.73l.62.65c.54.23 1.08.47 1.62.69l-1.7-1.78c.08-1.03.09-2.07.19-3.09-.05-.05-.09-.13-.11-.19l5.37 1.15c0 .11-.04.24-.04.35 1.33 1.42 2.66 2.89 4.05 4.29-.03.45-.08 1.5-.12 2.32.45.17.9.34 1.35.5.03-.76.09-1.8.11-2.24 1.21.26 2.44.52 3.67.74l4.48 4.4c.47.15.96.27 1.43.42l-5.67-5.52c.06-.98.21-1.91.31-2.9-.29-.28-.55-.55-.81-.81l5.98.77c1.39 1.35 2.73 2.58 4.1 3.78-.08 1.68-.15 3.39-.23 5.14-1.2-.15-2.44-.35-3.67-.46 1.88.6 3.77 1.2 5.67 1.77-.22-.21-.46-.41-.69-.61.05-1.77.15-3.55.23-5.36.92.11 1.84.18 2.78.27l6.22 5.56-.08 2.54c3.16.83 6.38 1.59 9.61 2.32.01-.1-.01-.2 0-.31l6.99-.46 2.44 1.89v.85c.43.08.85.15 1.27.23v-.81c1.33-.11 2.63-.23 3.93-.38v-.23l4.09 2.78c.69.11 1.39.21 2.08.31l-6.1-4.09.07-1.31 6.41-1.04c1.05.65 2.04 1.29 3.01 1.93l-.07 4.98c.45.06.9.13 1.35.19l.08-4.94c1.34-.26 2.65-.55 4.02-.81 0-.2.03-.42 0-.62l5.75 3.32c-.16.04-.33.11-.46.15-.08 1.25-.09 2.48-.12 3.71.16.09.36.22.54.31 2.96.29 5.92.53 8.92.73 0-.02.02-.05 0-.08l.2.08c.89.06 1.78.14 2.67.2l-2.74-1.31c0-.44-.03-.91 0-1.35l6.44-2.39c1.02.5 2.06 1.05 3.05 1.47.02 1.41-.01 2.63-.04 3.9l1.24.03c.01-1.3.06-2.54.08-3.97 1.39-.54 2.8-1.07 4.13-1.62v-.35l5.25 2.09c-.05.05-.05.06-.08.08-.07 1.24-.07 2.62-.07 3.86.11.04.26.07.39.12h.62c2.52 0 5.03-.06 7.52-.12.36-.17.71-.34 1.08-.5l1.35.42c.94-.03 1.88-.04 2.82-.08l-4.13-1.35c0-.59.05-1.23.08-1.82l6.14-3.24c1.07.33 2.14.68 3.24 1.01v5.14c.43-.02.85-.05 1.28-.07-.03-1.84-.01-3.46-.04-5.37 1.41-.79 2.75-1.52 4.17-2.24 0-.09-.04-.18-.04-.27l5.25 1.27c-.11 1.25-.04 2.49-.04 3.71l-4.21 2.55 1.85-.15 3.44-2.08c.99.26 2.13.47 3.12.73v.7c.43-.05.85-.07 1.27-.12.01-.32 0-.64 0-.96 1.34-.79 2.59-1.64 3.9-2.51l6.14.96c-.18.15-.47.31-.65.46v.81c3.22-.46 6.42-.94 9.58-1.5.02-.33.02-.65.04-1l7.06-5.48c.68.02 1.38.06 2.08.08.07 1.5.12 3.03.12 4.56.44-.1.9-.17 1.35-.27-.02-1.63-.06-3.3-.08-4.94 1.07-.85 2.2-1.75 3.32-2.63l6.18.04c-.08.04-.11.17-.19.23 0 1.25-.01 2.58.04 3.78l-2.24 1.89c.6-.14 1.21-.31 1.81-.46l1.63-1.39c.99.05 1.99.04 3.01.08v.12c.4-.11.8-.24 1.2-.35-.01-.13 0-.24 0-.38 1.33-1.2 2.65-2.35 4.01-3.55-.02-.07-.04-.09-.04-.16l5.29-.34c0 .58-.01 1.15 0 1.7 3.94-1.25 7.79-2.6 11.58-4.01l-2.05.35c-.02-.74-.02-1.44-.08-2.12l5.83-5.94c1-.18 2.03-.42 3.05-.66.16 1.92.23 3.6.31 5.41l-.35.35c1.55-.63 3.08-1.27 4.59-1.93-1 .21-1.98.43-2.97.66-.05-1.77-.14-3.38-.19-5.33 1.04-1.09 2.1-2.22 3.13-3.32l-1.31-4.28c-.78.16-1.57.34-2.32.46-.1-1.76-.14-3.35-.27-5.21.28-.31.57-.62.85-.92l-2.7-8.96c.08 1.17.14 2.32.2 3.55-.63.63-1.19 1.26-1.81 1.89l-7.3 1c0-.35-.04-.69-.04-1.04l6.33-6.68c.71-.14 1.39-.27 2.08-.38l-1.62-5.33-.08.08-7.18 1.01-.07-2.16 5.36-5.44-.46-1.46c0 .29.03.57.04.85l-5.48 5.52c-1.2.13-2.39.26-3.51.35-.06-1.74-.08-3.52-.16-5.29 1.13-1.04 2.22-2.07 3.32-3.16l5.06-.65-.27-1-4.21.54c0-.74.02-1.57.04-2.35l2.78-2.74-.27-.81-2.66 2.62c-1.31.22-2.64.43-3.89.54-.08-1.79-.05-3.55-.08-5.36 1.36-1.22 2.56-2.4 3.98-3.78v-.62l.62-.07-.27-.93-.35.04c0-.31.07-.64.07-.97l-.35-1.16c-1.31.17-2.47.28-3.71.38 0-1.79-.09-3.58-.12-5.37.6-.52 1.22-1.05 1.82-1.59l-1.66-5.48h-.04v-.08l-1.39-4.56c.03 1.78.05 3.53.08 5.29-.79.7-1.52 1.37-2.28 2.05l-6.87.46c0-.74.01-1.51.04-2.32-.26.04-.52.07-.81.12l5.86-5.44c1.34-.04 2.64-.06 3.98-.19l-1.73-5.64c-.33.28-.67.57-1.01.85l-6.41.3c0-.76.01-1.45.04-2.24l5.52-5.06-.62-2.01c-.01.5 0 1 .04 1.47l-5.88 5.42c-1.1.02-2.19.05-3.21.07-.05-1.7-.1-3.44-.07-5.21 1.41-1.13 2.79-2.29 4.2-3.51l4.32-.19-.31-.93-4.06.15c-.03-.94.01-1.89.04-2.89-.55.02-1.04.02-1.58.04l3.74-3.43-.23-.81-4.71 4.32c-.47.02-.96.08-1.43.08 0-1.73-.05-3.49-.08-5.21 1.33-1.2 2.69-2.38 4.05-3.52v-1.43l.38-.04-.31-.96v-.03l-.42-1.35c-1.28.04-2.55.04-3.78.04 0-1.77.01-3.53.03-5.32.57-.49 1.14-.96 1.7-1.43l-2.93-9.65c.02 1.54.04 3.08.04 4.59-.47.41-.92.78-1.39 1.19l-7.56-.35v-1.7l5.56-4.67c1.01.06 2.12.19 3.16.23l-1.82-5.9-6.99-.23c0-.57-.05-1.11-.08-1.7l5.18-4.29-.27-.84-5.09 4.32c-1.18-.05-2.45-.05-3.63-.12-.02-1.08-.03-2.19-.04-3.28-.44.12-.88.26-1.31.38v3.32c-.74.59-1.45 1.21-2.21 1.77l-6.75-.46v-1.43l1.66-1.31c-3.77.9-7.61 1.71-11.51 2.43l1.5.19c-.31.22-.61.43-.93.65v3.86h.12l-5.1 3.67v-.2c-1.36-.22-2.67-.41-3.98-.7 0-1.77.07-3.55.12-5.36l1.47-.96c-1.77.27-3.56.5-5.36.73l2.7.65v5.09c-.78.52-1.58 1.06-2.39 1.54l-6.67-1.58c0-.74.05-1.46.08-2.2-.16-.05-.33-.1-.46-.12l5.06-3.16c-.64.08-1.29.15-1.93.23l-4.36 2.66c-.76-.16-1.53-.33-2.32-.5-.01-.49 0-.98 0-1.47-.41.03-.82.04-1.23.08v1.66c-.63.32-1.23.61-1.78.96l-6.68-2.08c-3.23.16-6.48.27-9.76.31 0 .33-.01.67 0 1l-6.1 2.97c-.97-.35-1.92-.72-2.89-1.09.03-1.01.05-1.99.08-2.97-.39-.01-.78-.03-1.16-.04-.03 1-.09 1.99-.12 3.01-.47.15-.9.37-1.35.5l-7.68-3.63v-.3c-3.03-.2-6.05-.43-9.03-.74 0 .41-.04.84-.04 1.24l-6.17 1.78c-.89-.57-1.84-1.1-2.7-1.66.01-.81.01-1.62.03-2.43-.45-.06-.9-.12-1.35-.19-.02.77-.05 1.53-.08 2.32-1.07.22-2.22.45-3.32.69l-5.71-3.74c.05-.02.17 0 .2 0v-.73c-3.43-.63-6.8-1.35-10.12-2.12l-5.17.54c-.96-.72-1.89-1.43-2.77-2.12 0-.14-.01-.29 0-.43-.4-.11-.8-.24-1.2-.35v.35c-1.02.04-2.07.09-3.09.11l-2.59-2.12c-.03-.01-.05-.03-.08-.04zm16.598 4.825c1.073.807 2.18 1.668 3.28 2.43-.026 1.747-.09 3.455-.115 5.135-1.204.13-2.412.3-3.59.386l-5.442-4.13c.03-1.03.05-2.1.16-3.17l5.72-.66zm7.102 2.277l6.137 4.053c0 .917-.064 1.824-.038 2.74l-5.6 1.274c-1.08-.807-2.13-1.513-3.21-2.277.02-1.812.06-3.587.11-5.442.86-.088 1.69-.195 2.58-.348zm100.473.31l5.558.5c-.2.153-.37.328-.57.502 0 1.245-.07 2.484-.07 3.706l-5.75 4.593c-1.05-.13-2.1-.25-3.13-.424.03-1.768.07-3.56.04-5.327 1.28-.873 2.58-1.84 3.94-2.78v-.77zm14.127 1.08l6.176.193c-.315.262-.6.565-.965.85-.05 1.156-.1 2.25-.07 3.318l-5.4 4.44c-1.17-.023-2.36-.073-3.51-.117-.02-1.77-.02-3.52-.07-5.29 1.29-1.03 2.53-2.1 3.86-3.17V199zm-128.11.733l5.443 4.207c-.105 1.026-.18 2.024-.232 3.05l-5.86.5c-.99-.72-1.98-1.44-2.89-2.16.06-1.746.05-3.554.08-5.365 1.13-.044 2.3-.144 3.48-.232zm71.33 1.428l6.1 1.93c-.34.218-.74.443-1.08.618 0 .85.05 1.64.076 2.47l-6.33 3.474c-.89-.306-1.723-.6-2.587-.926.02-1.79.07-3.576.07-5.366 1.23-.59 2.46-1.215 3.74-1.89v-.31zm-36.668.31c1.073.588 2.168 1.202 3.32 1.813-.053 1.65-.04 3.35-.04 5.05-1.307.43-2.564.86-3.82 1.27l-5.327-3.01c.053-1.12.102-2.23.155-3.36-.027-.02-.052-.1-.078-.12l5.79-1.66zm8.338.038l6.29 3.087c-.444.11-.866.3-1.31.39 0 1.05-.015 2.08.037 3.13l-5.635 2.13c-1.125-.54-2.183-1.05-3.204-1.62 0-1.81.05-3.65.077-5.44 1.283-.39 2.488-.76 3.744-1.19v-.46zm62.993 0c.89.11 1.836.22 2.78.308.025 1.834-.052 3.653-.078 5.443-.576.37-1.072.787-1.62 1.158l-7.412-1.08c.026-.416.013-.783.04-1.198l6.29-4.632zm-42.96.81c1.02.437 2.117.826 3.164 1.197 0 1.77-.013 3.54-.04 5.33-.68.31-1.352.56-2.006.89l-6.9-2.74c0-.61.05-1.22.08-1.85l5.71-2.82zm57.048.502c.89.044 1.8.09 2.75.154.05 1.768.13 3.543.16 5.288-.65.502-1.27 1.02-1.93 1.544l-7.02-.54v-1.66l6.06-4.786zm-34.39 2.547l5.37 1.39c-.13.066-.21.127-.35.193-.15 1.353-.23 2.577-.23 3.82.21.066.47.073.66.117L417 214.56c-1.257-.306-2.527-.604-3.784-.888-.026-1.81-.064-3.614-.04-5.404 1.335-.785 2.772-1.49 4.054-2.277 0-.21.025-.42 0-.61zm-65.54.04c1.13.72 2.34 1.512 3.52 2.276l-.15 5.25c-.94.17-1.92.3-2.89.5l-6.17-4.09c0-.92 0-1.81.08-2.71l5.64-1.24zm8.11 1.774l5.52 3.32c-.08.85-.15 1.713-.15 2.586L359 214.83c-.942-.568-1.876-1.148-2.74-1.737.105-1.79.18-3.448.23-5.172 1.1-.24 2.22-.51 3.32-.73zm48.17 0v.31c1.26.37 2.59.692 3.98 1.04-.02 1.79-.01 3.62.04 5.367-.39.24-.81.42-1.23.617l-7.91-2.35c0-.67.02-1.32.04-2.04l5.1-2.93zm-70.13.618c1.02.786 2.15 1.557 3.17 2.277 0 1.768-.02 3.51-.07 5.21-1.12.09-2.32.167-3.47.233l-5.63-4.285c0-.938.05-1.862.08-2.78l5.95-.655zm50.91.154l5.79 2.27c-.26.11-.55.27-.81.38-.05 1.04-.08 2.08-.08 3.09l-6.68 3.2c-.73-.29-1.52-.56-2.27-.89 0-1.79.05-3.51.08-5.25 1.31-.61 2.63-1.2 3.94-1.86.03-.33.04-.64.04-.97zm42.38.46l6.26.88c-.34.24-.66.47-1 .73-.08 1.2-.11 2.32-.11 3.43l-5.13 3.82c-1.28-.15-2.62-.4-3.82-.62 0-1.77.02-3.56 0-5.37 1.25-.85 2.54-1.73 3.82-2.67.03-.09 0-.14 0-.23zm-51.33.5c1.23.68 2.45 1.28 3.79 1.89 0 1.79-.05 3.5-.08 5.25-1.18.41-2.3.78-3.39 1.15l-5.75-2.9c0-1.14.01-2.23.19-3.32l5.25-2.09zm-56.89.11c1.28 1 2.52 1.97 3.75 2.89-.08 1.74-.1 3.45-.15 5.17-.76 0-1.5.03-2.24.07l-6.87-5.64c.03-.77.08-1.48.16-2.24l5.36-.27zm22.35.81l5.75 3.82c0 1.02-.09 2.03-.11 3.01l-5.56 1.2c-1.12-.72-2.15-1.47-3.2-2.16.05-1.79.05-3.6.08-5.41 1.02-.13 2.06-.29 3.05-.47zm100.28.46l5.75.5c-.23.17-.48.36-.69.54-.08 1.22.01 2.44-.12 3.7l-5.9 4.63c-1.02-.13-2.03-.2-2.97-.31-.05-1.79-.03-3.61 0-5.45 1.36-.9 2.63-1.77 3.94-2.7 0-.31-.02-.65 0-.93zm-21.77.85c.79.19 1.54.4 2.35.58v5.25c-.6.37-1.2.75-1.77 1.12l-7.18-1.74c.03-.31 0-.58 0-.89l6.6-4.33zm36.05.11l6.33.19c-.42.35-.83.75-1.27 1.08-.05.96 0 1.88 0 2.78l-6.02 4.98c-.99-.05-1.97-.05-2.97-.12-.02-1.81-.04-3.58-.04-5.33 1.31-1.05 2.62-2.08 3.98-3.17v-.43zm-128.18.81l5.1 3.9c-.02 1.09-.04 2.11-.04 3.16l-6.13.58c-.94-.72-1.94-1.44-2.85-2.17.03-1.72.11-3.5.16-5.29 1.23-.04 2.5-.11 3.79-.19zm71.22 1.04l6.76 2.12c-.57.3-1.15.62-1.7.92 0 1.24-.08 2.47 0 3.67l-5.09 2.78v-.12c-1.28-.48-2.58-.87-3.86-1.35.03-1.77.09-3.56.12-5.37 1.21-.59 2.45-1.29 3.78-1.85v-.81zm-36.9.69c1.13.61 2.22 1.28 3.4 1.85-.03 1.74-.02 3.44-.08 5.17-1.09.33-2.18.65-3.28 1l-5.83-3.48c.06-.92.04-1.81.12-2.7-.08-.05-.18-.11-.23-.16l5.91-1.7zm71.14.15c1.05.15 2.13.22 3.21.31 0 1.85-.03 3.64 0 5.48-.39.24-.75.51-1.12.77l-7.95-1.05.07-1.32 5.79-4.21zm-43.15.62c1.18.52 2.47.9 3.78 1.38-.03 1.7-.04 3.42-.04 5.17-1.18.63-2.41 1.27-3.67 1.81l-5.36-2.13c0-1.09.03-2.19.08-3.28-.15-.05-.34-.09-.5-.16l5.71-2.82zm-20.69.11l6.18 3.12v2.28l-6.18 2.27c-.91-.46-1.89-.93-2.78-1.39.06-1.79.13-3.55.16-5.36.89-.29 1.76-.6 2.63-.93zm78.2.54c1.02.04 1.94.15 2.94.19.03 1.77.13 3.56.16 5.32-.49.39-1.04.76-1.54 1.15l-7.45-.66c-.02-.44-.02-.84 0-1.28l5.91-4.75zm13.43 1.08c1.34.02 2.59.09 3.9.11v5.4c-.26.22-.55.44-.81.66l-8.14-.19v-1.66l5.05-4.33zm-47.74.92l6.02 1.54c-.34.19-.68.37-1.04.61-.08 1.26-.06 2.56-.08 3.78.02 0 .1.05.15.07l-5.82 3.67c-1.1-.27-2.19-.57-3.28-.85 0-1.75.01-3.5.04-5.29 1.3-.79 2.6-1.57 3.93-2.36 0-.39.05-.8.08-1.19zm-65.85.42c1.15.78 2.42 1.56 3.63 2.39-.03 1.75-.08 3.41-.08 5.13-.89.2-1.8.37-2.74.54l-6.29-4.21c0-.94.07-1.8.2-2.74l5.29-1.12zm8.84 1.73l4.94 2.97c-.03.89-.03 1.78-.08 2.7l-6.64 1.81c-.73-.46-1.49-.92-2.2-1.35-.03-1.77-.05-3.5 0-5.29 1.39-.26 2.67-.57 3.98-.85zm-22.58.58c1.04.85 2.06 1.64 3.16 2.43-.08 1.68-.1 3.37-.16 5.05-.99.13-1.96.18-2.93.27l-6.17-4.79c.05-.81.09-1.58.12-2.32l5.98-.66zm71.36.23c.97.28 2.03.57 3.05.81 0 1.77-.02 3.5 0 5.25-1.07.59-2.06 1.24-3.08 1.81l-6.21-1.97c0-.79.09-1.63.11-2.44l6.14-3.48zm22.31.11l6.76 1c-.55.37-1.12.77-1.7 1.12-.05 1.22 0 2.29 0 3.36l-5.48 3.97c-1.2-.15-2.37-.37-3.47-.66-.02-1.75-.07-3.54-.07-5.33 1.31-.85 2.64-1.67 3.97-2.59v-.89zm-108.73.81c1.18 1 2.4 2 3.71 3.01-.02 1.7-.06 3.41-.11 5.13-1.2.04-2.44.11-3.59.15l-5.71-4.86c.08.02.18.04.23.04.08-1.14.09-2.23.19-3.32l5.29-.16zm56.94.04c1.33.74 2.69 1.47 4.05 2.08-.02 1.72-.11 3.43-.11 5.17-.94.35-1.81.7-2.7 1l-6.37-3.21c0-1.07.05-2.09.08-3.16l5.06-1.89zm8.65.42l5.48 2.2c-.05 1.05-.08 2.12-.08 3.13.19.06.41.14.62.23l-5.83 2.74c-1.28-.5-2.51-.98-3.74-1.55.05-1.7.13-3.5.15-5.25 1.13-.5 2.25-.98 3.4-1.5zm-42.81.62l5.72 3.74c-.1.02-.24.12-.35.12 0 .92.03 1.72 0 2.55l-6.09 1.19c-.91-.64-1.86-1.24-2.78-1.89.03-1.82.1-3.64.16-5.45 1.08-.11 2.24-.14 3.36-.27zm100.28.11l6.33.5c-.42.35-.88.69-1.27 1-.08 1.11-.09 2.15-.12 3.24l-6.49 5.06c-.84-.11-1.68-.24-2.47-.35 0-1.77.05-3.55.08-5.37 1.28-.88 2.58-1.82 3.94-2.82v-1.27zm14.28.96l6.91.27c-.6.5-1.2.95-1.77 1.43-.08 1.24-.04 2.48-.04 3.74l-5.02 4.2v-.04c-1.31-.07-2.64-.1-3.98-.12-.02-1.83-.07-3.63-.07-5.44 1.33-1.03 2.64-2.07 3.97-3.21v-.85zm-36.59.23c.97.24 1.93.42 2.9.62v5.21c-1.2.74-2.38 1.53-3.59 2.28l-5.52-1.39v-2.82l6.22-3.9zm-91.86.39l5.1 3.97c-.13 1.05-.15 2.06-.15 3.09l-6.6.58c-.79-.59-1.56-1.15-2.32-1.74.06-1.77.05-3.54.08-5.33 1.34-.07 2.59-.11 3.9-.2.03-.13 0-.26 0-.39zm-18.14.54c.76.04 1.52.07 2.28.11l6.53 5.56-.07 1.81-6.21.07c-.89-.76-1.75-1.46-2.58-2.2l.08-5.37zm52.1 1.55c1.26.67 2.62 1.34 3.9 2.08-.05 1.72-.09 3.44-.12 5.17-1.02.26-1.99.62-3.01.92l-6.14-3.55c.03-.89-.05-1.77 0-2.66-.15-.13-.35-.24-.54-.35l5.91-1.62zm71.6.42c1.1.17 2.21.26 3.36.34-.03 1.84-.01 3.65-.04 5.48-.97.66-1.91 1.36-2.86 2.01l-6.25-.85c0-.91-.05-1.83 0-2.81l5.79-4.17zm-35.08.34l5.91 1.93c0 1.07.08 2.1.08 3.17l-5.56 3.05c-1.26-.39-2.39-.83-3.51-1.31l.07-5.41c1.02-.5 2.02-.93 3.01-1.43zm-28.1.2l5.67 2.82c-.05.02-.06.08-.11.08 0 1.31-.08 2.54-.15 3.78.06.02.11.01.16.04l-5.06 1.89v-.2c-1.36-.66-2.68-1.32-3.94-1.97 0-1.79.04-3.58.12-5.37 1.13-.38 2.22-.78 3.32-1.08zm21.5.58c.73.28 1.45.53 2.24.81v5.29c-1.1.46-2.13 1.01-3.13 1.47l-5.83-2.28c0-.68.06-1.41.08-2.08l6.64-3.21zm55.74.15c1.15.09 2.31.23 3.44.27 0 1.77.07 3.52.12 5.29-1.07.83-2.09 1.62-3.16 2.43l-5.86-.54c0-.96.02-1.88.12-2.82-.13-.02-.29-.08-.42-.08l5.79-4.56zm14.9 1.12l2.97.15c.03 1.79.08 3.56.08 5.32-.89.76-1.81 1.44-2.7 2.16l-6.29-.15c0-.79-.04-1.57-.04-2.36l5.99-5.13zm-115.26.89v.04c1.39.85 2.67 1.73 4.06 2.62-.03 1.77-.05 3.57-.08 5.25-.7.13-1.41.28-2.12.39l-6.94-4.52c0-.94.05-1.86.16-2.78l4.94-1zm65.97 1.42l5.45 1.39c-.05 1.07 0 2.06 0 3.12l-5.83 3.71c-1.07-.27-2.19-.5-3.24-.74 0-1.77.02-3.53.07-5.32 1.18-.75 2.35-1.49 3.55-2.17zm-56.7.5l5.1 3.01c-.05 1.26-.14 2.36-.19 3.59.16.08.36.16.54.27l-5.82 1.66c-1.2-.77-2.45-1.56-3.63-2.28 0-1.75.01-3.58.04-5.37 1.31-.27 2.72-.51 3.98-.78v-.11zm-23.16.3c1.21.94 2.47 1.86 3.67 2.78-.02 1.72-.11 3.43-.11 5.13-1.33.15-2.79.21-4.13.23 0 .06.03.11 0 .15l-4.98-3.82c0-1.22.08-2.43.16-3.59-.16-.11-.38-.2-.54-.31l5.95-.58zm71.72.65c1.07.33 2.22.6 3.4.89-.03 1.79-.09 3.6-.12 5.36-.84.43-1.65.89-2.43 1.35l-6.68-2.16c0-.72-.05-1.44 0-2.2l5.83-3.25zm-86.54.42v.07c1.39 1.16 2.77 2.22 4.13 3.24-.05 1.72-.06 3.42-.12 5.17-1.02.04-2.04.12-3.08.12l-6.09-5.06c.03-1.13.15-2.27.23-3.47l4.94-.08zm66.47.81l5.1 1.97c-.13 1.2-.16 2.28-.08 3.55l-5.75 2.78c-1.07-.46-2.17-.92-3.24-1.35.03-1.73.04-3.5.04-5.29 1.31-.53 2.65-1.05 3.94-1.66zm41.96.34l5.95.92c-.05.98-.05 1.85-.08 2.82l-5.87 4.24c-1.07-.11-2.07-.29-3.09-.51-.03-1.79-.03-3.61 0-5.4 1.07-.65 2.09-1.36 3.09-2.08zm-49.87.08c.92.48 1.84.89 2.78 1.31-.02 1.74-.02 3.52-.07 5.29-.78.28-1.6.58-2.39.85l-6.6-3.09c0-.64.05-1.32.08-1.97l6.22-2.4zm-34.93.15l5.75 3.78c-.23.04-.48.09-.69.15 0 1.27-.1 2.54-.23 3.78.03.02.1-.01.16.04l-5.09.96v-.27c-1.33-.89-2.61-1.71-3.9-2.58 0-1.79.05-3.58.08-5.37 1.34-.15 2.66-.3 3.94-.5zM423.1 236c.996.24 1.928.48 2.897.7v5.172c-1.02.7-2.04 1.32-3.09 1.93l-5.982-1.47c0-.823.027-1.66.078-2.47l6.1-3.86zm22.39.158l5.134.503c-.05 1.22-.076 2.41-.076 3.55.105.02.23.08.31.08l-5.674 4.48c-1.23-.13-2.45-.25-3.63-.43v-5.36c1.31-.92 2.66-1.83 3.94-2.82zm-132.51.387c.917.068 1.774.135 2.664.157l6.02 5.17c-.024 1.18.016 2.323-.036 3.436.027.042.064.053.117.075l-5.093.078c0-.06-.03-.12 0-.19-1.31-1.09-2.54-2.3-3.823-3.43l.15-5.29zm17.216.08l5.868 4.71c-.03.874 0 1.75 0 2.625.233.173.445.364.654.54l-5.79.5c-1.31-.96-2.565-2.006-3.82-3.01.025-1.746.088-3.504.114-5.25.996-.02 1.98-.05 2.974-.116zm128.96.696l5.83.19c-.027.63.026 1.2 0 1.82l-7.22 5.99c-.653 0-1.327-.01-1.93-.03.028-1.79.028-3.56 0-5.32 1.048-.83 2.17-1.73 3.32-2.62zm-22.81 1.54c1.28.16 2.54.41 3.9.58.024 1.79.075 3.56.075 5.33-.81.57-1.61 1.08-2.39 1.58l-6.75-.89c-.02-.93.02-1.85.04-2.81l5.14-3.78zm-34.084.16l5.83 1.86c-.13.09-.27.17-.425.23v3.25l-5.44 3.01c-1.123-.43-2.26-.87-3.433-1.31-.027-1.79-.027-3.57 0-5.36 1.15-.56 2.296-1.16 3.473-1.66zm-35.664.2c.838.44 1.686.84 2.47 1.28-.025 1.75-.114 3.47-.114 5.17-.863.22-1.734.47-2.624.74l-6.522-3.67c.02-.5-.03-1.04 0-1.54l6.79-1.97zm7.065.12l5.75 2.86c-.104.07-.18.14-.31.16 0 1.14-.023 2.28-.075 3.44l-5.21 1.93c-1.18-.67-2.4-1.24-3.63-1.81.027-1.81.052-3.65.08-5.48 1.1-.39 2.244-.73 3.396-1.08zm20.96.54c.967.33 1.9.69 2.894 1.08-.03 1.71-.03 3.4 0 5.1-1 .53-2.04.97-3.09 1.47l-6.06-2.39.074-2.31 6.18-2.93zm57.47.47c.84.07 1.71.07 2.55.12-.026 1.79-.09 3.54-.116 5.33-.947.72-1.83 1.44-2.74 2.16l-6.22-.65c0-.61.014-1.2.04-1.81l6.485-5.13zm12.856.04v.97c1.31.05 2.7.04 3.98.04v5.41c-.71.59-1.34 1.16-2.05 1.7l-6.91-.19-.04-2.93c-.34 0-.68.03-1.04 0l6.06-4.98zM351.69 243c.942.61 1.902 1.264 2.82 1.854-.03 1.746-.04 3.508-.04 5.21-1.204.22-2.425.417-3.63.657l-5.79-3.86c.08-.02.154-.05.233-.07 0-.76.036-1.62.036-2.47l6.37-1.31zm65.232.348l5.095 1.197c-.052 1.092 0 2.173 0 3.242l-6.292 3.976c-.913-.24-1.82-.478-2.74-.696v-5.288c1.31-.79 2.68-1.58 3.94-2.32v-.12zm-57.24.27l5.48 3.204c-.13.044-.295.034-.426.078-.078 1.287-.038 2.538-.038 3.782l-5.867 1.62c-1.05-.653-2.1-1.312-3.13-1.967.06-1.79.05-3.61.08-5.443 1.31-.218 2.66-.45 3.86-.733.03-.18.02-.37.04-.54zm48.517 1.467c1.12.306 2.31.56 3.51.89v5.363c-.79.415-1.55.76-2.28 1.197l-6.79-2.083v-2.278l5.56-3.09zm-70.37.232c.76.567 1.57 1.17 2.35 1.737-.03 1.7-.04 3.37-.04 5.094-1.28.11-2.5.265-3.71.31l-5.45-4.17c.02-.786.02-1.57.07-2.354l6.75-.617zm50.48.85l4.94 1.89c-.06 1.223 0 2.523 0 3.745v.038l-5.87 2.74c-1.05-.436-2.12-.854-3.17-1.312.05-1.724.04-3.465.04-5.21 1.31-.59 2.77-1.22 4.05-1.853v-.038zm42.65.154l5.75.89c-.13.09-.27.2-.43.31-.05 1.29-.06 2.56-.12 3.78.02 0 .09-.02.11 0l-5.06 3.63v-.07c-1.39-.21-2.73-.43-4.02-.69v-5.37c1.26-.76 2.49-1.6 3.74-2.47zm-108.08.04c.94.79 1.87 1.54 2.82 2.24-.03 1.77-.04 3.48-.12 5.25-1.02 0-2.02.02-3.01.04l-6.06-5.13c0-.7.05-1.46.08-2.2l6.29-.19zm56.93.12c1.1.53 2.17 1.06 3.24 1.59 0 1.75-.02 3.52-.04 5.29-.63.22-1.25.48-1.85.7l-7.22-3.55c0-.59.01-1.21.04-1.77l5.82-2.24zm-36.05.51l6.48 4.36c0 .96.05 1.86 0 2.82l-5.29.97c-1.23-.8-2.45-1.66-3.63-2.47 0-1.81.06-3.63.11-5.44.78-.06 1.53-.14 2.31-.23zm101.78 1.35l5.13.47c-.03 0-.05.06-.08.08-.05.74-.08 1.44-.08 2.16l-7.72 5.99c-.45-.06-.91-.12-1.35-.19 0-1.76.05-3.56.07-5.32 1.36-.98 2.68-1.88 4.02-2.82-.03-.11 0-.24 0-.34zm-22.93.04c1.1.29 2.2.52 3.36.73 0 1.77-.03 3.51 0 5.25-.95.59-1.83 1.16-2.74 1.7l-6.41-1.43c.02-.83.07-1.71.07-2.58l5.71-3.67zm-110.01.35c.97.04 1.95.15 2.89.19l5.98 5.1c-.05 1.09-.07 2.21-.12 3.28h-5.17c-1.26-1.07-2.49-2.15-3.67-3.2.05-1.76.05-3.55.07-5.36zm17.83.23l5.44 4.29c-.05.98-.12 1.93-.12 2.94.08.09.16.21.27.27l-5.83.43c-1.18-.87-2.31-1.65-3.44-2.51.08-1.81.13-3.55.15-5.32 1.07-.04 2.31-.01 3.51-.07zm148.99.2c-.03.02-.05.04-.08.04-.05 1.01-.07 1.94-.04 2.86l-6.99 6.49c-.68.05-1.43.12-2.09.12v-5.32c1.33-1.13 2.65-2.25 4.01-3.47v-.46l5.17-.23zm-19.8.23l5.86.16c-.15.13-.34.28-.5.39-.05 1.18-.04 2.31-.04 3.4l-5.44 4.56c-1.2-.05-2.4-.09-3.55-.16 0-1.79-.03-3.53-.08-5.32 1.25-.98 2.54-2.03 3.74-3.01zm-93.76 1.97c.99.53 2.02 1.04 3.01 1.58-.03 1.73-.12 3.47-.12 5.18-1.39.4-2.7.73-3.98 1.12l-5.25-3.08c.05-1 .06-2.04.11-3.09l6.21-1.7zm36.55.04l5.75 1.85c-.19.11-.37.28-.58.39 0 .99-.05 1.94-.08 2.86l-5.83 3.17c-1.02-.35-2.06-.71-3.08-1.08 0-1.79.13-3.61.15-5.44 1.23-.56 2.38-1.12 3.66-1.73zm35.09.12c.92.13 1.81.31 2.7.46 0 1.75-.03 3.46 0 5.25-.71.48-1.42 1.08-2.12 1.58l-6.95-1.04c0-.57-.03-1.05 0-1.62l6.37-4.63zm-63.57.04l5.98 2.9c-.31.06-.58.22-.92.31 0 1.09.01 2.24-.04 3.36l-5.4 1.97c-1.16-.59-2.33-1.19-3.55-1.77 0-1.81.01-3.63.03-5.44 1.31-.42 2.61-.8 3.9-1.2v-.11zm76.58.54c0 .18.01.38.04.58 1.36.2 2.69.29 4.05.31 0 1.79.04 3.6.04 5.37-.76.61-1.56 1.22-2.32 1.81l-6.76-.58c0-.72-.03-1.47 0-2.24-.52-.06-.99-.11-1.54-.19l6.48-5.06zm-56.12.16c.99.39 2.03.77 3.05 1.12-.06 1.72-.04 3.44-.04 5.21-.87.37-1.71.79-2.55 1.16l-6.64-2.62c0-.65.05-1.29.07-1.93l6.1-2.93zm71.17 1.58c1.1.02 2.25.02 3.43.04v5.4c-.66.53-1.27 1.02-1.9 1.55l-7.14-.15v-2.12l5.59-4.71zm-114.6 1.19c1.1.7 2.18 1.4 3.28 2.12-.03 1.73-.07 3.51-.04 5.25-1.05.2-2.07.41-3.09.58l-6.18-4.17c0-.87.11-1.71.19-2.58l5.83-1.2zm65.81.62l5.21 1.2c-.13 1.31-.12 2.66-.12 3.9.15.05.33.05.54.12l-5.72 3.63c-1.25-.29-2.56-.61-3.82-.89v-5.37c1.31-.76 2.62-1.51 3.9-2.28v-.31zm-57.98 1.12l5.82 3.44c-.11.96-.16 1.87-.16 2.78l-5.83 1.62c-1.04-.65-2.05-1.29-3.04-1.93 0-1.74-.03-3.51 0-5.32 1.1-.18 2.18-.36 3.2-.58zm-21.58.54c.91.7 1.82 1.43 2.74 2.09-.03 1.73-.08 3.47-.16 5.17-1.21.11-2.38.17-3.56.2l-5.6-4.32c.02-.85.07-1.73.15-2.58l6.41-.54zm70.36.04c1.38.46 2.69.81 4.05 1.16v5.33c-.55.31-1.03.57-1.58.85l-7.57-2.28c0-.76.05-1.53.07-2.28l5.01-2.78zm-105.69.19l6.37.47c.92.81 1.84 1.61 2.78 2.4-.02 1.73-.03 3.41-.03 5.14-1.39-.09-2.81-.22-4.17-.35l-5.05-4.67c.02-.98.03-1.99.11-2.97zm86.27.62l5.45 2.09c-.13.07-.28.21-.38.27-.13 1.16-.08 2.23-.08 3.32l-6.37 2.93c-.89-.32-1.79-.69-2.7-1.04 0-1.77-.02-3.52.04-5.29 1.38-.54 2.79-1.1 4.05-1.73v-.54zm-66 .08c1.1.94 2.23 1.85 3.36 2.74l-.15 5.1c-.81.02-1.62 0-2.43 0l-6.72-5.63c0-.74.02-1.44.08-2.16l5.87-.03zm108.85.54l5.83.89c-.18.16-.37.26-.58.39-.02.59.03 1.2 0 1.81l-7.2 5.13c-.6-.11-1.25-.2-1.85-.31v-5.4c1.25-.78 2.48-1.65 3.82-2.51zm-51.37.12c1.1.57 2.24 1.04 3.28 1.54-.02 1.79-.06 3.52-.11 5.29-1.13.46-2.31.82-3.44 1.19l-5.83-2.82c.07-.02.18-.05.23-.07 0-.96.01-1.96.04-2.97l5.82-2.16zm-35.51.35l6.02 4.05c0 1.13-.1 2.17-.15 3.24l-5.29.93c-1.23-.85-2.44-1.6-3.59-2.39-.03-1.81.01-3.65.04-5.48 1.02-.09 2-.22 2.97-.35zm101.44 1.42l5.4.5-.31.19c-.08 1.23-.08 2.43-.08 3.67l-5.98 4.75c-1.02-.13-2.1-.25-3.09-.38V264c1.36-.94 2.69-1.836 4.05-2.818v-.54zm-133.2.19c1.18.11 2.35.15 3.55.23l5.79 5.02c-.18-.02-.35-.04-.5-.04 0 1.12.01 2.2-.04 3.25l-5.41-.04c-1.18-1.04-2.38-2.05-3.47-3.01 0-1.79.05-3.59.07-5.4zm17.91.31l5.48 4.29c-.08 1.03-.04 2.11-.04 3.2l-5.95.38c-1.02-.78-2.08-1.6-3.13-2.43.05-1.77.07-3.5.07-5.29 1.18-.04 2.34-.06 3.55-.15zm93.33 0c.76.2 1.54.37 2.35.5 0 1.75.01 3.51.04 5.21-.79.5-1.54 1.01-2.36 1.47l-6.83-1.7c0-.44.05-.84.08-1.28l6.72-4.21zm56.16.23c-.13.09-.21.22-.31.31-.08 1.37-.08 2.6-.08 3.82h.5l-5.83 5.33c-1.29.09-2.49.19-3.75.23-.08-1.7-.11-3.46-.08-5.21 1.38-1.2 2.69-2.37 4.05-3.55v-.61l5.48-.31zm-19.8.31l5.83.15c-.21.2-.48.42-.74.62l-.08 2.39-6.56 5.48c-.84-.02-1.66-.07-2.47-.11.02-1.75.02-3.5 0-5.29 1.28-1.05 2.63-2.2 4.01-3.24zm-23.78 1.2v1.08c1.31.16 2.69.3 4.05.43v5.44c-.47.35-1 .6-1.47.93l-7.65-1c.03-.8.05-1.58.08-2.39l-1.01-.19 5.98-4.28zm-33.7.5l6.33 1.97c-.4.24-.81.44-1.2.66-.08 1.2-.08 2.47-.08 3.67l-4.98 2.74c.03-.02 0-.02 0-.04-1.41-.43-2.74-.88-4.05-1.39.02-1.79.07-3.61.07-5.4l3.9-1.85v-.35zm-36.86.31c.96.55 1.96 1.12 3.01 1.66 0 1.73-.02 3.43-.04 5.17-1.21.33-2.38.58-3.55.89l-5.68-3.36c.02-.87.06-1.76.11-2.66l6.13-1.7zm6.75.81l6.44 3.13c-.03.83-.04 1.7-.04 2.51l-5.71 2.13c-1.1-.52-2.16-1.06-3.2-1.58v-5.36c.84-.31 1.67-.55 2.51-.81zm21.54 0c1.12.44 2.24.92 3.4 1.35-.03 1.73-.01 3.47-.04 5.18-1.31.61-2.62 1.23-3.9 1.82l-5.33-2.16c0-1.15.01-2.26.12-3.36-.06-.02-.13-.01-.16-.03l5.9-2.78zm89.51.74c0 1.76.09 3.58.12 5.32-.63.59-1.28 1.15-1.93 1.74l-7.3.5c0-.39.07-.72.07-1.12l6.75-6.25c.76-.04 1.49-.1 2.27-.19zm-31.84.07c.99.05 2.03.09 3.05.16.02 1.75.05 3.48 0 5.29-.63.5-1.25 1.08-1.93 1.58l-7.14-.69c0-.57-.03-1.09 0-1.66l6.02-4.67zm13.39.54v.39c1.28.09 2.68.14 4.01.12-.03 1.79.01 3.58.04 5.33-.45.37-.88.75-1.35 1.12l-7.72-.15c0-.77-.03-1.54-.08-2.28-.11-.02-.14-.01-.27-.04l5.36-4.48zm-114.13 1.52c1.1.7 2.26 1.43 3.35 2.13-.03 1.75-.04 3.54-.04 5.29-.97.16-1.92.27-2.86.43l-6.33-4.24c-.03-.85.02-1.73.07-2.62l5.79-.96zm65.96.27l5.67 1.36c-.16.09-.31.2-.47.31 0 .72-.08 1.5-.08 2.24l-7.68 4.79c-.47-.13-.96-.23-1.43-.38 0-1.72-.03-3.48 0-5.25 1.33-.78 2.64-1.54 3.97-2.35v-.69zm-57.75 1.36l5.45 3.21c-.05.96-.05 1.89-.07 2.78l-6.52 1.62c-.83-.5-1.69-1.06-2.51-1.58.03-1.76.05-3.57.08-5.36 1.23-.22 2.39-.42 3.59-.66zm-22.11.5c.94.74 1.84 1.47 2.86 2.16-.02 1.73-.07 3.47-.07 5.17-1.04.09-2.01.15-3.01.2l-6.1-4.7c0-.78.05-1.59.08-2.35l6.26-.46zm-35.24.04l6.33.46c.94.85 1.93 1.7 2.82 2.55-.05 1.66-.11 3.3-.11 4.98-1.1-.02-2.32-.06-3.55-.19l-5.56-5.21c0-.85 0-1.71.08-2.58zm107.04.58c.97.26 1.94.57 2.94.85 0 1.77-.02 3.54 0 5.33-1.04.57-2.13 1.17-3.2 1.74l-5.98-1.97c.03-.85.02-1.69.04-2.58l6.22-3.35zm-92.6.2h5.75c1.13 1.01 2.29 1.97 3.44 2.82 0 1.75.03 3.49 0 5.21-1.23.03-2.5-.06-3.7-.15l-5.59-4.87c.05-1.01.07-2.03.12-3.01zm115.03.35l6.41.97c-.41.31-.81.59-1.23.85-.05 1.09-.09 2.23-.11 3.32l-5.48 3.94c-1.2-.17-2.39-.37-3.51-.61v-5.37c1.31-.83 2.63-1.64 3.94-2.58v-.5zm-52.03.43c1.2.63 2.48 1.14 3.71 1.66 0 1.79-.04 3.62-.04 5.37-.94.37-1.86.66-2.78.97l-6.33-3.01c.03-.98.09-1.98.12-3.01l5.33-1.97zm8.26.31l5.83 2.28c-.05 1.03-.05 2.05 0 3.05.16.07.36.13.54.2l-5.86 2.7c-1.25-.46-2.55-.99-3.86-1.58 0-1.74.05-3.52.08-5.28 1.13-.41 2.24-.85 3.28-1.35zm-43.23.16l5.87 3.94c-.05.96-.07 1.96-.07 2.9l-5.9 1.01c-1.02-.7-2.03-1.4-3.05-2.12v-5.36c1.05-.11 2.1-.24 3.17-.35zm-46.55.66c1.21.22 2.44.4 3.67.62l5.29 4.94c-.05.94-.06 1.89-.11 2.78l-6.33-.62c-.94-.92-1.9-1.85-2.81-2.74.11.07.19.09.27.15.05-1.7.04-3.43.04-5.13zm147.99.42l5.91.66c-.29.24-.61.38-.92.62-.05.92-.09 1.85-.04 2.74l-7.06 5.37c-.68-.06-1.27-.15-1.93-.19 0-1.83-.02-3.63 0-5.44 1.34-.92 2.67-1.74 4.05-2.74 0-.33-.02-.65 0-1zm-23.58.04v.5c1.31.35 2.66.61 4.02.89 0 1.77-.04 3.52-.04 5.29-1.25.79-2.46 1.56-3.74 2.28l-5.44-1.31c0-1.09.01-2.2.12-3.32-.47-.13-.92-.2-1.39-.31l6.49-4.01zm-109.77.35c.82.07 1.6.11 2.44.2l6.41 5.6c-.02.79-.15 1.55-.15 2.36l-5.75-.11c-1.02-.93-2.12-1.82-3.16-2.74.03-1.79.15-3.54.23-5.29zm18.34.2l5.06 3.94c-.13 1.09-.13 2.19-.15 3.28l-6.37.47c-.91-.72-1.77-1.4-2.66-2.08.05-1.79.05-3.56.08-5.32 1.36 0 2.62-.09 4.06-.11v-.15zm149.84.2c-.26.24-.52.47-.81.74-.1 1.31-.13 2.59-.15 3.9.13-.02.31-.05.47-.07l-5.87 5.41c-1.23.07-2.47.14-3.7.16.03-1.74.04-3.46.04-5.21 1.34-1.13 2.67-2.33 3.98-3.51-.02-.32 0-.73 0-1.08l6.06-.31zm-20.46.31l6.45.08c-.47.35-.86.73-1.31 1.08-.05 1.29-.04 2.62-.04 3.86l-5.06 4.29v-.15c-1.36-.09-2.67-.1-3.97-.19v-5.29c1.29-1.05 2.58-2.05 3.94-3.16v-.5zm-94.95 2.12c1.21.66 2.4 1.29 3.63 1.97-.02 1.73 0 3.45 0 5.13-1.15.33-2.27.59-3.39.85l-5.94-3.43.08-2.81c-.02-.04-.15-.07-.23-.12l5.87-1.58zm71.8.62c1.1.18 2.3.27 3.47.43 0 1.79-.04 3.58-.04 5.37-.99.68-1.96 1.41-2.93 2.08l-6.09-.89c0-1-.03-1.97 0-2.93l5.6-4.05zm-63.84.27l5.79 2.9.04 3.71c.03.02.05.06.08.08l-5.17 1.97c0-.11-.02-.26 0-.35-1.33-.67-2.61-1.23-3.89-1.89v-5.44c1.05-.32 2.12-.66 3.17-.96zm28.33.04l6.3 2.09-.08 1.39-7.33 3.86c-.57-.19-1.11-.44-1.66-.65.03-1.79.04-3.59.04-5.4.92-.41 1.83-.83 2.74-1.27zm-6.29.58c.76.27 1.53.59 2.32.85-.03 1.75-.08 3.52-.08 5.29-1.1.51-2.16.95-3.28 1.43l-5.87-2.16v-2.12l6.91-3.28zm55.62.23v.16c1.39.05 2.76.09 4.1.16.05 1.77.03 3.56 0 5.33-1.1.9-2.21 1.76-3.28 2.63l-5.9-.5c0-.98.07-2.02.12-3.09-.31-.02-.66-.09-1-.11l5.98-4.55zm32.97.04l.08 5.37c-1.1.98-2.24 1.97-3.32 3.01l-5.9.39c0-.76.05-1.55.08-2.31l6.6-6.21c.81-.06 1.63-.14 2.47-.23zm-17.29 1.12c.92.02 1.81.08 2.78.08 0 1.77-.02 3.47 0 5.21.13-.06.3-.09.43-.11-1.07.92-2.14 1.74-3.16 2.55l-6.29-.12-.04-2.24 6.29-5.36zm-116.1.66c1.31.85 2.57 1.73 3.82 2.51l-.07 5.25c-.76.11-1.52.2-2.28.31l-6.91-4.55c.03-.81.14-1.62.2-2.47l5.25-1.04zm-48.94 1.66l6.02.54c1.15 1.05 2.32 2.04 3.47 3.09 0 1.7-.04 3.41-.04 5.14-1.2-.11-2.37-.27-3.55-.38l-5.79-5.4c.05-.92.08-1.87.16-2.74-.1-.11-.16-.14-.27-.23zm114.91.12l5.79 1.43c-.06 1-.08 2.03-.08 2.97l-6.02 3.71c-1-.28-2.01-.51-3.05-.73v-5.37c1.1-.63 2.23-1.31 3.36-2zm-8.61.08c0 .35-.04.69-.04 1.04 1.31.39 2.76.79 4.09 1.12v5.33c-.86.48-1.7.94-2.51 1.35l-6.72-2.08c0-1.05.11-2.15.16-3.24-.39-.08-.73-.14-1.04-.27l6.06-3.24zm-48.4.12l5.17 3.01c-.11 1.14-.16 2.36-.16 3.51.18.07.36.2.54.31l-5.83 1.5c-1.23-.76-2.4-1.51-3.63-2.27l.07-5.4c1.31-.24 2.57-.44 3.83-.65zm-22.89.08c1.12.83 2.24 1.7 3.39 2.55-.03 1.73-.12 3.41-.12 5.18-1.36.11-2.69.17-4.05.19l-5.21-4.05c0-1.09.12-2.24.19-3.4l5.79-.46zm-20.34.74l5.29.04c1.28 1.05 2.59 2.1 3.98 3.17-.03 1.81-.08 3.34-.08 5.17-1.05.02-2.07.03-3.09-.04l-6.26-5.37c.02-.98.05-2.01.15-2.97zm71.99 1.51l5.25 2.05c-.03.63-.04 1.24-.04 1.85l-7.87 3.51c-.44-.17-.87-.32-1.31-.54-.03-1.74.02-3.54.08-5.29 1.31-.5 2.64-1.01 3.9-1.58zm-43.23.12h.08l5.71 3.94c-.23.02-.44.06-.65.08.02 1.29-.07 2.62-.04 3.86l-4.98.89v-.19c-1.42-.96-2.75-1.83-4.06-2.81.03-1.82.03-3.6.08-5.37 1.33-.09 2.63-.23 3.86-.39zM380 284c.91.46 1.87.91 2.82 1.35-.05 1.75-.1 3.5-.08 5.25-.91.33-1.85.66-2.74.96l-6.406-3.12c0-.63.1-1.31.155-1.97L380 284zm-82.41.27c1.26.26 2.54.54 3.82.73l5.1 4.86c-.13 1.05-.22 2.19-.19 3.28.21.2.39.37.58.54l-5.91-.57c-1.39-1.35-2.73-2.72-4.02-4.01.16.07.32.15.502.23.02-1.72.09-3.35.11-5.05zm132.86.12l5.94.89c-.057.77-.08 1.52-.08 2.24l-6.64 4.63c-.81-.11-1.58-.29-2.39-.46-.02-1.81 0-3.574 0-5.364 1.02-.63 2.12-1.27 3.17-1.93zm-118.38.85c.99.09 1.96.11 2.93.19l5.9 5.14c.02 1.28.02 2.52 0 3.74l.032.04-5.14-.16V294c-1.28-1.18-2.56-2.383-3.82-3.475.03-1.81.08-3.574.08-5.364zm17.52.5l5.86 4.6c-.076.91-.126 1.89-.15 2.85.232.2.495.35.73.5l-5.907.47c-1.286-1-2.556-2.02-3.786-3.05 0-1.85.072-3.43.072-5.29 1.08 0 2.15.01 3.17-.07zm93.37.27c.99.26 2.04.54 3.09.74 0 1.745-.07 3.52-.04 5.29-1.02.64-2.03 1.28-3.05 1.89l-6.14-1.5c0-.85.02-1.71.08-2.585l6.06-3.82zm22.46.43l5.4.5c-.1 1.156-.14 2.206-.04 3.32.11.02.26.04.39.04l-5.91 4.515-3.74-.38c.05-.023.1-.08.15-.08-.027-1.77-.027-3.58-.078-5.33 1.31-.872 2.59-1.65 3.82-2.585zm34.24.656c-.05 1.18.01 2.29-.04 3.51.08.02.23.04.31.04l-5.75 5.29c-1.34.086-2.7.21-3.9.23.1-.045.21-.11.35-.156-.05-1.725-.09-3.466-.12-5.21 1.252-1.115 2.59-2.203 3.894-3.36l5.25-.35zm-20.34.35l5.9.12c-.05 1.286.012 2.56-.04 3.78l-4.94 4.25v-.155c-1.257-.02-2.64-.09-3.976-.11-.03-1.77-.064-3.53-.116-5.32 1.02-.85 2.07-1.69 3.17-2.55zm-23.01 1.04v.54c1.26.13 2.59.33 3.98.47.02 1.744.13 3.5.15 5.25.11-.024.17-.036.27-.08-.86.655-1.77 1.22-2.66 1.853l-6.79-1c-.02-1.02-.04-2 .04-3.09-.13-.02-.216-.06-.347-.08l5.365-3.86zm-43.23.2v.58c1.36.564 2.82 1.02 4.21 1.5-.03 1.75-.08 3.53-.08 5.25-.998.48-2.046.87-3.01 1.35l-6.19-2.36c.023-.92.09-1.84.113-2.82-.5-.17-.984-.323-1.51-.5l6.447-3.01zm-27.06.23c.813.46 1.65.89 2.51 1.35-.05 1.724-.11 3.45-.11 5.17-.89.27-1.88.5-2.82.74l-6.41-3.82v-1.59l6.83-1.85zm36.014.115l5.71 1.89c-.08.05-.2.07-.27.12 0 1.11-.02 2.17-.04 3.24l-5.48 2.9c-1.23-.4-2.35-.76-3.48-1.2-.03-1.77.05-3.57.08-5.36 1.15-.5 2.27-1.04 3.47-1.58zm-29.103.15l5.75 2.9v1.9l-7.21 2.55c-.63-.29-1.24-.58-1.81-.89 0-1.77.05-3.56.08-5.37 1.07-.33 2.13-.73 3.2-1.08zm110.9 1.08c.03 1.73.03 3.47.08 5.21-.89.83-1.7 1.67-2.59 2.47l-6.37.39c0-.63-.04-1.31-.04-1.96l6.26-5.87c.89-.09 1.78-.17 2.67-.23zm-31.42.08c.81.05 1.62.14 2.43.16.05 1.79.1 3.62.15 5.37-.92.72-1.86 1.4-2.78 2.12l-6.41-.54c.03-.61-.02-1.27 0-1.93l6.6-5.17zm14.05 1.01c.92 0 1.81.04 2.78.04.08 1.73.1 3.49.15 5.21.21-.11.45-.22.66-.3-.92.76-1.87 1.49-2.78 2.24l-6.95-.12-.04-1.78 6.17-5.29zM351 292.38c.917.61 1.85 1.203 2.82 1.813 0 1.746-.038 3.48-.117 5.25.052 0 .152-.023.23 0-1.177.217-2.425.442-3.55.617l-5.75-3.86v-.116c0-.872-.015-1.662.037-2.47l6.33-1.235zm8.146.85l5.17 3.164c-.025.02-.024.015-.076.037-.08.72-.09 1.52-.116 2.28l-7.758 1.89c-.524-.3-1.047-.62-1.544-.92h.154c.13-1.75.167-3.46.193-5.21 1.31-.24 2.62-.47 3.93-.73l.04-.5zm57.626.153l5.21 1.195c-.025.874-.025 1.68 0 2.51l-7.14 4.438c-.758-.196-1.518-.426-2.277-.58.08-.02.165 0 .27 0l-.077-5.287c1.335-.75 2.706-1.45 4.015-2.28zm-115.564.694l6.948.694c.76.7 1.48 1.39 2.238 2.05-.078 1.71-.165 3.42-.27 5.1-.942-.11-1.914-.14-2.856-.23l-6.137-5.91c0-.54.05-1.13.08-1.69zm35.974.463c.706.568 1.492 1.152 2.277 1.698-.03 1.724-.11 3.45-.16 5.172-1.18.088-2.35.227-3.48.27l-5.75-4.477c0-.72.06-1.425.11-2.124l6.98-.54zm70.79.347c1.23.35 2.386.698 3.59 1.004l.04 5.21c.13-.02.266-.03.424-.07-.995.51-1.913 1.05-2.934 1.55l-6.64-2.16c-.025-.83-.05-1.68 0-2.51l5.52-3.01zM378.63 295v.77c1.283.677 2.6 1.265 4.092 1.854 0 1.768-.012 3.482-.038 5.25h.386c-.812.283-1.608.57-2.393.81l-7.102-3.397c-.053-.938-.053-1.88 0-2.818-.288-.13-.523-.28-.81-.43l5.865-2.04zm-63.03.192l6.484.154c.89.786 1.85 1.53 2.818 2.316-.027 1.812-.09 3.323-.116 5.134-1.047.022-2.08.022-3.05 0l-6.253-5.674c.053-.655.09-1.253.116-1.93zm72.372.772l5.095 2.084c-.052 1.18-.04 2.34-.04 3.474l-6.02 2.74c-1.125-.436-2.26-.93-3.358-1.39h.27c.05-1.723.1-3.447.07-5.17 1.31-.48 2.67-1.05 3.97-1.66v-.078zm-90.94.27c1.31.24 2.667.554 4.054.772 0-.11.026-.178 0-.31l4.825 4.826c-.05.72.01 1.42-.07 2.162l-7.68-.927c-.42-.436-.91-.837-1.35-1.273.11-2.03.18-4.202.23-5.25zm46.397.077l6.17 4.25v1.47l-7.22 1.16c-.63-.43-1.23-.81-1.86-1.27.1-1.79.11-3.59.19-5.4.89-.06 1.78-.1 2.7-.19zm87.42.12l5.9.93c-.11.07-.27.09-.35.16-.06 1.27.06 2.55.04 3.86l-4.98 3.51v-.11c-1.44-.19-2.74-.43-4.02-.69l-.12-5.36 3.51-2.28zm-119.12.81c1.04.13 2.03.23 3.05.27l5.71 5.21c0 .61-.09 1.21-.12 1.82l-7.26-.31c-.61-.56-1.16-1.02-1.74-1.54.07-1.79.24-3.63.34-5.44zm183.5.08c-.26.26-.5.53-.74.77-.05 1.31-.03 2.65.07 3.94.1-.04.23-.1.31-.07l-5.18 5.33c-.03-.15-.01-.27-.04-.42-1.44.13-2.86.3-4.17.39-.08-1.81-.14-3.58-.19-5.32 1.31-1.18 2.57-2.43 3.93-3.74l5.98-.85zm-165.44.66l5.36 4.21c-.06.61-.13 1.25-.16 1.86l-7.61.43c-.47-.39-.98-.75-1.43-1.12.02-1.85.09-3.45.11-5.32 1.2 0 2.47-.01 3.7-.04zm92.21.12c1.36.4 2.7.74 4.17 1.01l.07 5.25c-.97.61-2.01 1.21-2.98 1.78l-6.33-1.43c0-.91-.07-1.79-.04-2.66-.26-.06-.55-.16-.81-.23l5.91-3.71zm23.77.39l5.17.43c-.08 1.38-.06 2.6 0 3.82h.38l-5.75 4.56c-1.23-.11-2.47-.25-3.67-.38-.08-1.88-.1-3.47-.16-5.37 1.33-.91 2.73-1.84 4.01-2.89v-.15zm34.27.54c-.03.07-.06.07-.12.12 0 1.18.03 2.37.08 3.59l-5.83 5.71c-1.13.07-2.27.08-3.32.12-.06-1.85-.07-3.39-.12-5.29 1.36-1.18 2.74-2.41 4.13-3.55v-.35l5.17-.34zm-20.11.47l5.9.08c-.13.09-.26.24-.39.35 0 1.31-.02 2.51.04 3.71l-4.98 4.29v-.04c-1.36 0-2.75-.03-4.06-.11-.03-1.74-.05-3.58-.08-5.32 1.15-.96 2.35-1.99 3.55-2.93zm-95.88.23c0 .18.04.37.04.54 1.31.72 2.71 1.57 4.21 2.36-.03 1.75-.07 3.49-.12 5.21-1.26.33-2.53.64-3.71.97l-5.59-3.29c.05-1.02.1-2.12.15-3.16-.5-.32-1.01-.66-1.51-.96l6.52-1.66zm9.5 1.58l5.75 2.78c-.26.09-.51.22-.77.31v3.36l-5.41 2.01c-1.31-.63-2.53-1.22-3.71-1.85.08-1.74.01-3.57.04-5.36 1.31-.41 2.63-.82 4.09-1.23zm28.48 0l5.83 1.86c-.16.07-.3.15-.43.23 0 .77-.06 1.55-.04 2.36l-6.44 3.47c-.84-.3-1.71-.6-2.55-.88-.03-1.81.06-3.55.04-5.36 1.2-.5 2.38-1.07 3.59-1.66zm35.47.27c.92.11 1.85.29 2.89.35 0 1.95.04 3.56.11 5.48-.73.48-1.41.95-2.09 1.43l-7.1-1.04c.05-.59.12-1.18.12-1.81l6.06-4.4zm-43.39.47c.97.37 1.96.75 3.01 1.08 0 1.77-.03 3.54 0 5.33-.92.39-1.75.79-2.59 1.16l-6.6-2.66c0-.67-.03-1.31 0-2.01l6.17-2.89zm89.97.54c.08 1.77.18 3.54.23 5.33-.81.79-1.64 1.57-2.47 2.36l-6.6.46c-.03-.72-.08-1.48-.08-2.2l5.83-5.59c.99-.07 2.06-.19 3.08-.35zm-31.54.27c.86.04 1.66.06 2.47.08.05 1.79.09 3.6.12 5.33-.86.72-1.72 1.43-2.59 2.13l-6.6-.58c0-.61-.07-1.14-.04-1.77l6.64-5.17zm-102.98.19v1.05c1.28.81 2.63 1.73 4.01 2.63-.03 1.86-.07 3.36-.12 5.21-.89.16-1.81.31-2.7.47l-6.52-4.51c.02-1.02.14-2.05.19-3.12-.34-.24-.62-.49-.96-.73l6.1-.96zm116.95.78c.99 0 1.93-.02 2.93 0 .08 1.9.18 3.53.23 5.4-.71.57-1.45 1.11-2.13 1.7l-7.03-.11c-.03-.59 0-1.24 0-1.85l5.98-5.13zm-167.33.15l6.49.73c0 .13.02.28 0 .43 1.36 1.31 2.77 2.64 4.13 3.9l-.2 5.06c-.89-.08-1.79-.18-2.63-.27l-6.49-6.25c0-.79 0-1.48.08-2.2-.47-.44-.92-.91-1.39-1.39zm35.71 1.08c.02.11-.01.22-.04.31 1.36 1.09 2.84 2.27 4.28 3.36-.05 1.68-.12 3.34-.19 5.02-1.15.07-2.26.19-3.36.19l-5.79-4.55c.02-.96-.01-1.9.07-2.81-.45-.37-.91-.73-1.36-1.12l6.37-.38zm-20.54.31l6.06.27c0 .28-.04.58-.04.89 1.28 1.09 2.63 2.22 4.01 3.4-.11 1.82-.19 3.36-.27 5.18-.73-.02-1.46-.07-2.16-.11l-6.95-6.17c.05-.9.09-1.78.11-2.74-.24-.24-.51-.45-.77-.69zm102.52.35l5.25 1.24c-.05 1.25-.07 2.53-.12 3.75.21.06.38.13.54.19l-5.79 3.51c-1.28-.28-2.58-.62-3.86-.93-.03-1.86-.01-3.39-.04-5.25 1.36-.76 2.75-1.56 4.01-2.39v-.11zm-58.44.54l5.6 3.32c-.06 1.01-.11 2.03-.16 3.05l-6.02 1.47c-.97-.59-2.01-1.22-3.01-1.85l.04-5.36c1.18-.24 2.38-.38 3.55-.62zm49.02.54v.46c1.44.39 2.78.77 4.17 1.16.03 1.88.01 3.49.04 5.37-.58.31-1.15.61-1.7.89h-.12l-7.49-2.31c.03-.85.01-1.69.11-2.58-.16-.02-.26-.15-.39-.19l5.36-2.78zm-19.53 1.16l5.4 2.16c-.08.02-.2.1-.27.12-.13.92-.11 1.83-.08 2.74l-7.18 3.25c-.73-.31-1.55-.63-2.28-1 .13 0 .26.02.39 0l-.04-5.13c1.36-.53 2.69-1.05 4.06-1.66-.03-.16 0-.31 0-.47zm-91.52.35c1.31.27 2.65.56 4.01.89v-.62l5.21 5.1c-.08 0-.19.04-.27.04-.13 1.09-.22 2.33-.27 3.59l-5.98-.69c-1.02-1.02-2.04-2.04-3.01-3.05.1-1.98.25-4.22.31-5.25zm47.32.27l5.83 4.06h-.2c-.03 1.07-.1 2.17-.16 3.24l-5.67.89c-1.12-.85-2.27-1.67-3.4-2.54.1-1.75.15-3.54.23-5.33 1.12-.11 2.2-.18 3.36-.31zm35.35 0c1.15.53 2.35 1.02 3.55 1.55v5.14c.16 0 .31.03.46 0-1.36.46-2.66.9-3.86 1.32l-5.87-2.93c0-.96-.02-1.95.04-2.93l5.67-2.12zm52.11.2l5.9.96c-.18.11-.32.26-.5.39-.11 1.24-.04 2.45.04 3.63l-5.1 3.66c-1.41-.24-2.69-.51-3.97-.77 0-1.85-.1-3.61-.16-5.44 1.25-.78 2.52-1.56 3.78-2.43zm-120.12.66c1.25.13 2.55.32 3.86.39h.04l5.67 5.13c-.24 0-.46-.01-.7-.03l-.11 3.36-5.52-.27c-1.15-1-2.29-2.1-3.44-3.12l.19-5.44zm184.89.2c-.37.33-.7.67-1.04 1 .05 1.2.14 2.43.19 3.63l-4.86 5.14c-1.39.13-2.82.3-4.21.39-.05-1.75-.13-3.52-.23-5.29 1.31-1.26 2.63-2.52 3.93-3.74v-.27l6.21-.85zm-170.3.65c1.28 0 2.56.06 3.9.04l5.13 4.09c-.13 1.12-.18 2.21-.23 3.28 0 .03.05.12.07.12l-6.06.39c-1.05-.85-2.07-1.72-3.09-2.54.08-1.87.16-3.49.27-5.36zm120.19.54l5.59.47c-.1.09-.2.18-.31.27-.03 1.27.05 2.54.08 3.75.08 0 .16-.02.27 0l-5.79 4.44c-1.23-.09-2.43-.2-3.63-.31-.03-1.9-.07-3.52-.12-5.44 1.38-.94 2.72-1.78 3.97-2.74-.03-.15-.05-.31-.08-.42zm-22.24.31c.83.18 1.74.41 2.58.58.05 1.75.05 3.48.08 5.25-.81.48-1.64.89-2.4 1.35l-6.8-1.58c-.03-.48 0-.99 0-1.47l6.52-4.13zm57.01.27c-.11.09-.17.13-.27.23-.06 1.29 0 2.6.07 3.86.32-.02.6-.01.89-.03l-5.6 5.44c-1.52.09-2.93.23-4.37.27-.08-1.87-.15-3.44-.23-5.36 1.33-1.16 2.67-2.31 4.17-3.51 0-.15-.04-.35-.04-.5l5.36-.39zm-20.15.39h.07l5.87.12c-.24.2-.45.34-.66.54 0 1.14.1 2.16.16 3.2l-5.44 4.83c-1.28-.08-2.53-.11-3.71-.15-.06-1.81-.1-3.59-.16-5.4 1.28-1.03 2.53-2.08 3.86-3.13zm-95.53 1.51c1.02.57 2.08 1.21 3.12 1.78-.08 1.75-.09 3.43-.11 5.18-1.1.26-2.22.57-3.32.81l-6.06-3.59c.05-.85.11-1.67.19-2.55l6.17-1.62zm37.44.08l6.21 1.97c-.34.2-.69.39-1.08.54.02 1.2 0 2.47.08 3.71l-5.21 2.71c-.02-.05-.04-.09-.04-.16-1.38-.39-2.73-.85-4.01-1.35.05-1.77.08-3.57.08-5.36 1.33-.61 2.69-1.18 3.97-1.81 0-.06-.03-.16 0-.23zm96.76.31c.11 1.92.29 3.52.39 5.33-.42.44-.78.86-1.2 1.28l-7.99 1.27c-.02-.35.02-.71-.04-1.04l5.95-6.25c.99-.19 1.98-.38 2.89-.58zm-106.18.39v.16c1.33.53 2.72.95 4.13 1.43 0 1.77 0 3.53-.08 5.25-1.17.52-2.35 1.08-3.47 1.62l-5.71-2.28c0-1.02-.02-2.1.03-3.12-.32-.13-.56-.25-.85-.38l5.94-2.66zm-20.73.04l6.14 3.01v2.16l-6.76 2.36c-.79-.37-1.58-.77-2.32-1.16 0-1.81.01-3.61.04-5.44.96-.28 1.92-.6 2.89-.92zm65.5 0c.91.16 1.85.22 2.82.31.05 1.95.13 3.61.15 5.48-.49.4-.99.81-1.46 1.16l-7.76-1.12c-.02-.48.05-.93.08-1.39l6.18-4.44zm-152.51.97l5.83 1.16c1.08 1.24 2.17 2.39 3.32 3.55l-.27 5.02c-1.07-.22-2.17-.43-3.24-.7l-5.83-6.25c.11-.9.14-1.84.19-2.78zm199.36.16c.11 1.73.16 3.5.23 5.25-.68.61-1.31 1.24-1.96 1.85l-7.1.46c-.03-.26-.04-.52-.04-.81l6.83-6.56 2.04-.19zm-31.92.19c.87.07 1.75.03 2.67.08.05 1.77.08 3.6.08 5.41-.68.57-1.34 1.12-2.04 1.66l-7.07-.62c0-.54.08-.96.08-1.5l6.29-5.01zm13.82.93c1.1.02 2.29.08 3.44.08.11 1.9.16 3.47.23 5.33-.47.42-.97.82-1.46 1.24l-7.8-.08c-.03-.55-.04-1.15-.04-1.74l5.64-4.83zm-116.8.46c1.18.74 2.35 1.49 3.55 2.28-.02 1.86-.05 3.42-.07 5.25-.91.15-1.76.27-2.62.43l-6.71-4.59c.05-.81.12-1.6.12-2.43l5.75-.92zm-49.71.93l6.26.7c.97.9 1.94 1.81 2.93 2.71-.1 1.73-.19 3.49-.27 5.17-1.31-.15-2.52-.28-3.86-.5l-5.29-5.21c.05-.96.13-1.9.23-2.86zm117.11.08l5.52 1.32c-.1.07-.26.08-.42.19-.03 1.29-.09 2.51-.04 3.86.13.04.31.11.46.15l-5.83 3.63c-1.28-.3-2.54-.66-3.82-.96 0-1.88.04-3.49.04-5.37 1.31-.74 2.63-1.43 4.09-2.24-.02-.17 0-.38 0-.58zm-58.51.66l5.13 3.09c-.05.83-.05 1.66-.08 2.51l-7.25 1.74c-.66-.39-1.32-.78-1.97-1.2.05-1.88.13-3.49.15-5.36 1.39-.24 2.76-.46 4.02-.7v-.08zm-22.85.23c.97.74 1.89 1.56 2.86 2.28l-.11 5.17c-.92.02-1.87.07-2.78.11l-6.49-5.13c.06-.65.09-1.33.12-1.97l6.4-.46zm-21.19.42l5.94.16c1.13 1.01 2.27 2.01 3.4 2.97-.05 1.84-.11 3.32-.19 5.06-.73.02-1.46.03-2.16-.04l-7.1-6.25c0-.63.06-1.23.11-1.89zm94.14.97c.99.27 2.07.59 3.09.85v5.29c-1 .54-1.98 1.04-2.97 1.54l-6.45-2.04c-.03-.76.01-1.55.04-2.31l6.29-3.32zm-30.34.2v.39c1.39.64 2.82 1.28 4.21 1.89 0 1.88-.05 3.45-.08 5.33-.92.31-1.79.61-2.7.89l-6.64-3.16c0-1.09.14-2.13.19-3.2-.1-.04-.2-.09-.31-.15l5.33-1.96zm-82.64.16c1.18.19 2.36.41 3.51.65l5.48 5.36c-.05.67-.14 1.33-.19 2l-7.18-.92c-.66-.65-1.21-1.27-1.81-1.93.1-1.96.14-4.17.19-5.17zm47.75.46l5.86 4.01c-.1.02-.21.05-.35.08l-.07 2.31-6.75 1.04c-.81-.59-1.66-1.18-2.47-1.77l.15-5.33c1.18-.09 2.4-.22 3.63-.35zm88.08 0l6.44.92c-.36.28-.67.57-1.04.81 0 1.22-.13 2.45-.08 3.63l-5.21 3.63c-1.41-.29-2.77-.51-4.05-.77.03-1.79-.04-3.56-.04-5.37 1.34-.83 2.62-1.61 3.98-2.51v-.35zm-44.12.19l5.48 2.12c-.05 1.13-.06 2.2-.04 3.36.13.06.29.11.42.15l-5.87 2.67c-1.25-.46-2.5-.98-3.7-1.5.05-1.88.05-3.41.08-5.29 1.2-.48 2.4-.99 3.63-1.51zm109.66.46c-.45.5-.88 1.02-1.35 1.5 0 1.24.09 2.39.11 3.59l-4.9 5.13c-.02 0-.01-.02-.04 0-1.38.17-2.91.41-4.24.5-.08-1.77-.14-3.56-.2-5.33 1.31-1.24 2.66-2.53 4.02-3.82 0-.22-.04-.4-.04-.62l6.64-.97zm-186.59.11c1.33.13 2.67.24 3.97.35l5.71 5.21c-.23-.02-.51-.06-.77-.08-.11.85-.1 1.64-.16 2.43l-6.56-.31c-.76-.72-1.59-1.44-2.35-2.16.03-1.81.13-3.61.15-5.44zm18.68.46l5.25 4.21c-.05-.02-.1 0-.16 0-.11.94-.13 1.82-.16 2.74l-7.18.43c-.68-.54-1.38-1.09-2.01-1.66.08-1.83.15-3.41.23-5.29 1.31.02 2.67.06 3.98.04 0-.15.01-.33.04-.46zm117.18.77l5.83.58c-.24.15-.46.29-.7.46 0 1.26.03 2.48.08 3.7l-6.17 4.67c-1-.11-2.01-.22-2.98-.35-.05-1.79-.12-3.73-.12-5.48 1.28-.89 2.66-1.79 4.05-2.82v-.77zm35.39.5c-.29.26-.52.49-.81.74.08 1.33.07 2.64.15 3.82.19-.02.36-.04.54-.04l-5.86 5.52c-1.29.13-2.57.15-3.83.19-.05-1.81-.1-3.33-.15-5.21 1.33-1.15 2.64-2.45 4.05-3.63-.03-.35-.02-.67-.04-1l5.94-.38zm-57.98.23c.81.22 1.7.41 2.59.58.03 1.77.03 3.51 0 5.25-1.15.68-2.32 1.32-3.48 1.93l-5.79-1.31c.02-.76.08-1.53.08-2.31l6.6-4.13zm37.17.31l6.49.12c-.4.31-.79.66-1.16.97-.03 1.27.03 2.55.08 3.86h.54l-5.52 4.75c-.02-.19 0-.42 0-.61-1.41-.07-2.77-.15-4.13-.23-.08-1.75-.13-3.54-.15-5.33 1.28-1.05 2.61-2.06 3.9-3.13 0-.13-.07-.28-.04-.39zm-97.34 1.16h.03c1.39.77 2.72 1.61 4.13 2.4 0 1.71.08 3.41.08 5.18-1.04.26-2.1.49-3.2.77l-6.29-3.71c.03-.87.04-1.77.12-2.62-.29-.2-.56-.33-.85-.5l5.98-1.51zm9.07 1.24l5.83 2.81c-.08.02-.16.06-.24.08-.08 1.31-.05 2.54-.08 3.9l-5.13 1.85v-.16c-1.36-.68-2.64-1.31-3.98-1.97 0-1.81.01-3.59.04-5.4 1.18-.35 2.4-.7 3.55-1.12zm28.83.07l6.02 1.97.04 3.74-5.14 2.63v-.04c-1.38-.5-2.78-1.01-4.09-1.47.05-1.81.04-3.59.04-5.36 1.02-.48 2.08-.96 3.13-1.47zm36.39.15c1.02.18 2.07.36 3.09.47.03 1.7.11 3.6.16 5.33-.91.66-1.85 1.28-2.74 1.89l-6.67-1.04c-.06-.74 0-1.47 0-2.24l6.17-4.4zm-43.34.66c.78.28 1.63.58 2.47.89.03 1.75 0 3.52 0 5.29-1.02.46-2.04.84-3.01 1.28l-6.25-2.39c0-.65-.03-1.33 0-1.97l6.79-3.09zm90.71.43c.05 1.74.12 3.48.23 5.25-1 .96-2.03 1.88-3.05 2.82l-6.18.43c-.05-.7-.02-1.35-.07-2.04l6.6-6.25c.81-.02 1.63-.1 2.47-.19zm-32.74.27c1.05.04 2.17.08 3.24.08.02 1.81.1 3.62.15 5.41-1 .81-2.09 1.57-3.09 2.36l-6.17-.5c-.03-.92-.08-1.8-.08-2.74.02-.04 0-.04 0-.04l5.94-4.55zm53.69.15l-1.58 1.73c.57-.24 1.14-.48 1.7-.73l.15-.15-.27-.85zm-157.33.23v.46c1.31.88 2.7 1.79 4.09 2.7-.02 1.75-.07 3.36-.15 5.21-.66.09-1.32.2-1.97.31l-7.29-4.98c.05-.87.1-1.71.16-2.54-.11-.11-.25-.2-.35-.27l5.52-.89zm121.59.58c.1 1.92.15 3.57.23 5.4-.86.68-1.72 1.36-2.51 2.01l-6.76.04c0-.57-.02-1.12-.04-1.66l6.64-5.75c.81.02 1.59 0 2.43-.04zm-136.29 1.27c1.28 1.03 2.65 2.08 4.01 3.17l-.12 5.1-2.66.15-6.64-5.17c0-.74.05-1.51.08-2.23-.26-.22-.55-.44-.81-.62l6.14-.38zm-20.38.16l5.44.19c-.03.13-.03.29 0 .43 1.28 1.2 2.66 2.32 4.05 3.47l-.12 5.25c-1.13-.02-2.18-.14-3.28-.23l-6.1-5.44c.08-1.11.13-2.29.23-3.47-.08-.06-.16-.1-.24-.19zm44.54.35l5.25 3.12c-.05 1.24-.16 2.43-.23 3.63.18.11.35.2.54.31l-6.1 1.58c-1.23-.83-2.45-1.63-3.63-2.35l.07-5.33c1.36-.26 2.76-.52 4.09-.69v-.27zm58.51.42l5.44 1.27c-.06 1.1-.03 2.25 0 3.32.13.03.29.02.42.04l-5.94 3.63c-1.25-.33-2.52-.63-3.7-.89 0-1.85.03-3.39 0-5.29 1.23-.7 2.5-1.36 3.78-2.08zm-8.07 1.43c1.02.26 2.02.59 3.01.85.03 1.9.02 3.46.04 5.29-.81.44-1.58.84-2.39 1.28l-7.06-2.28c0-.61.06-1.2.04-1.81l6.37-3.32zm-65.27.54l5.98 4.17c-.32.05-.68.05-1.05.12.05 1.27.08 2.52.08 3.78l-5.17.81v-.04c-1.37-1-2.7-2.02-4.06-3.01l.15-5.29c1.36-.1 2.72-.29 4.05-.38v-.15zm44.16.12l5.21 2.12c-.03 1.24-.06 2.48-.12 3.74.15.04.34.13.5.19l-5.98 2.59c-1.28-.52-2.5-.96-3.71-1.5 0-1.85.03-3.43.03-5.28 1.39-.48 2.8-1.01 4.06-1.62v-.23zm-8.26.5c.99.46 1.98.9 2.97 1.31-.02 1.85-.08 3.43-.08 5.29-.83.28-1.64.56-2.43.85l-6.94-3.29c0-.59.04-1.18.04-1.77l6.45-2.39zm51.68.5l6.1.89c-.03.04-.09.05-.12.07-.05 1.07.08 2.14.08 3.21l-5.79 3.98c-1.18-.2-2.35-.44-3.47-.7l-.12-5.36c1.1-.7 2.22-1.36 3.32-2.09zm-102.37.19l5.36 4.21-.27.04c-.1 1.25-.12 2.53-.12 3.75.23.15.47.37.65.54l-6.18.23c-1.23-1-2.49-1.95-3.75-2.97l.15-5.25c1.36.02 2.71.02 4.13 0 .02-.2 0-.37 0-.54zm95.2 1.66c.86.22 1.72.4 2.58.62l.12 5.17c-.97.63-1.9 1.19-2.9 1.78l-6.45-1.58c-.03-.66 0-1.28 0-1.89l6.64-4.1zm22.66.23h.07l5.21.5c-.05 1.25-.06 2.39-.04 3.59.32.05.67.01 1.01.08l-5.9 4.52c0-.02.03-.02 0-.04-1.39-.15-2.82-.39-4.21-.5-.05-1.83-.05-3.42-.07-5.37 1.31-.92 2.61-1.82 3.94-2.78zm-81.49 2.2c.84.48 1.71.99 2.55 1.47 0 1.7.04 3.45.04 5.17-.87.2-1.75.42-2.59.66l-6.99-4.13c0-.46.08-.89.08-1.35l6.91-1.81zm36.98.19l6.02 1.93c-.13.07-.27.13-.43.2l.04 3.66-5.17 2.74v-.08c-1.38-.46-2.72-.89-4.05-1.35 0-1.77-.06-3.59-.11-5.4 1.23-.54 2.5-1.08 3.71-1.7zm-29.49.12l5.9 2.78c-.1.06-.25.07-.38.11.03 1.2.04 2.53.04 3.86l-5.25 1.89c0-.08-.02-.14 0-.23-1.33-.65-2.68-1.23-4.01-1.93v-5.4c1.23-.37 2.48-.69 3.71-1.08zm65.11.27c1.21.13 2.4.27 3.63.42.05 1.92.07 3.61.12 5.45-.68.46-1.44.97-2.12 1.47l-7.33-1.12c0-.74-.03-1.51 0-2.28l5.71-3.93zm-43.19.62c.84.33 1.7.62 2.59.92v5.25c-.99.41-1.9.84-2.81 1.23l-6.56-2.55c0-.61.02-1.24.04-1.85l6.76-3.01z" opacity=".5" fill="url(#p)" transform="translate(-52.625 -80.754)"/><path d="M316.956 25.45c5.374 1.007 9.927 8.026 9.368 13.466-19.77 192.38-43.02 401.558-61.48 587.278 0 0-2.056 12.883-7.026 15.81-20.938 12.324-50.854 4.386-72.605-6.442-14.843-7.39-28.576-21.015-33.374-36.888-4.97-16.434 3.36-34.305 7.61-50.94C202.76 377.98 259.9 191.096 301.73 35.696c1.59-5.907 9.21-11.374 15.224-10.247z" opacity=".106" fill="#fff" fill-rule="evenodd"/><path d="M370.327 8.122a30.172 4.76 0 1 1-60.344 0 30.172 4.76 0 1 1 60.344 0z" fill="#cb5c00"/><path d="M58.195 815.802s2.104 5.3 6.9 7.5c4.038 1.85 19.39 1.196 19.39 1.196H715.67c3.395 0 6.99-.052 10.08-1.46 3.327-1.515 3.974-5.186 3.974-5.186s1.633 6.453-2.103 10.417c-2.65 2.81-7.36 2.85-11.22 2.85-208.95.04-427.24 0-638.95 0-5.48 0-12.36.48-16.05-3.57-5-5.5-3.19-11.75-3.19-11.75z" fill="url(#q)" fill-rule="evenodd" transform="translate(-52.625 -80.754)"/><path d="M95.22 112.298c-2.48 7.106-2.138 11.64-6.958 13.914-11.292 5.33-23.62 4.094-35.72 4.28-12.337.192-24.91.12-36.925-2.54-3.174-.7-5.635-4.018-6.69-7.09-.69-2.017-2.284-3.706-2.007-6.69.07-.76.87-1.235 1.62-1.37.66-.12 1.745-.126 2.528.567 1.71 1.51 2.817 3.63 4.284 3.94 12.005 2.49 24.546 3.61 37.725 3.68 9.806.05 16.907-.05 26.355-2.68 1.576-.44 5.995-1.24 7.36-2.14.79-.53 1.38-1.31 2.14-1.88 1.395-1.05 2.676-2.67 4.414-2.81.677-.06 2.097.16 1.873.8z" transform="translate(122.74 174.656) scale(4.37655)" opacity=".709" fill="url(#r)" fill-rule="evenodd"/><path d="M-1.07 89.844s-2.623 1.892-2.98 4.29c-.608 4.06 3.995 9.692 6.52 12.927 2.72 3.48 8.266 5.94 12.34 7.64 6.356 2.66 13.346 3.64 20.192 4.41 6.58.74 14.585.46 21.19 0 6.808-.47 15.742-1.1 18.92-1.7 7.185-1.36 12.778-3.48 18.04-7 2.245-1.5 5.27-4.52 6.714-6.8 1.103-1.74 3.16-5.32 3.25-7.38.05-1.16-2.97-3.54-2.97-3.54s-1.593 10.77-16.408 17.13c-8.527 3.66-17.902 4.74-27.167 5.27-8.95.52-20.08.16-28.87-1.64-6.51-1.33-11.88-3.78-17.79-6.81-6.2-3.19-9.37-11.5-10.62-14.79-.94-2.48-.36-1.98-.36-1.98z" transform="translate(122.74 174.656) scale(4.37655)" fill="#ee6900" fill-rule="evenodd" filter="url(#s)"/></svg>`
 	// End of VLC icon.
@@ -13,9 +13,13 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid"
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
 )
 
-var validExt = []string{".avi", ".m4v", ".mkv", ".mp4", ".mpeg", ".mpg", ".mov", ".wmv"}
+var validExt = []string{".avi", ".m4v", ".mkv", ".mp4", ".mpeg", ".mpg", ".mov", ".webm", ".wmv"}
 
 func isValidExt(ext string) bool {
 	for _, i := range validExt {
@@ -26,6 +30,40 @@ func isValidExt(ext string) bool {
 	return false
 }
 
+var subtitleExt = []string{".ass", ".srt", ".ssa", ".vtt"}
+
+func isSubtitleExt(ext string) bool {
+	for _, i := range subtitleExt {
+		if ext == i {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDevices parses a comma separated list of device names. An empty string
+// means all the devices except the web preview.
+func parseDevices(s string) ([]vid.Device, error) {
+	if s == "" {
+		var out []vid.Device
+		for _, v := range vid.Devices() {
+			if v != vid.WEBPWebPreview {
+				out = append(out, v)
+			}
+		}
+		return out, nil
+	}
+	var out []vid.Device
+	for _, n := range vid.SplitList(s) {
+		v, ok := vid.DeviceByName(n)
+		if !ok {
+			return nil, fmt.Errorf("unknown device %q", n)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
 func getWd() string {
 	wd, _ := os.Getwd()
 	return wd
@@ -35,12 +73,35 @@ func mainImpl() error {
 	bind := flag.String("http", ":8010", "port and host to bind to")
 	rootDir := flag.String("root", getWd(), "root directory")
 	cacheDir := flag.String("cache", "", "cache directory, defaults to <root>/.cache")
-	lang := flag.String("lang", "fre", "preferred language")
+	ffmpegPath := flag.String("ffmpeg", "ffmpeg", "path to the ffmpeg executable")
+	ffprobePath := flag.String("ffprobe", "ffprobe", "path to the ffprobe executable")
+	probeTimeout := flag.Duration("probe-timeout", time.Minute, "maximum duration to analyze a file")
+	idet := flag.Bool("idet", false, "analyze frames to detect interlacing when the field order is unknown; slower")
+	lang := flag.String("lang", "fre", "preferred languages, comma separated in order of preference, e.g. \"fre,eng,und\"")
+	profiles := flag.String("profiles", "", "JSON file with device profiles to add or override")
+	devices := flag.String("devices", "", "devices to offer, comma separated, e.g. \"ChromeCast,ChromeOS\"; defaults to all")
+	allAudio := flag.Bool("all-audio", false, "keep all the audio tracks in transcoded files, not only the preferred language; \"all_audio\" in -profiles sets it per device")
+	hls := flag.Bool("hls", false, "stream MP4 transcodings with HLS while they are running, so they can be watched right away; the stream is kept along the MP4 file")
 	log.SetFlags(log.Lmicroseconds)
 	flag.Parse()
 	if flag.NArg() != 0 {
 		return errors.New("unexpected argument")
 	}
+	ffmpeg.Default = &ffmpeg.Commands{FFprobe: *ffprobePath, FFmpeg: *ffmpegPath}
+	if *profiles != "" {
+		if err := vid.LoadProfiles(*profiles); err != nil {
+			return err
+		}
+	}
+	devs, err := parseDevices(*devices)
+	if err != nil {
+		return err
+	}
+	if *allAudio {
+		for _, d := range devs {
+			d.Profile().AllAudio = true
+		}
+	}
 
 	root, err := filepath.Abs(*rootDir)
 	if err != nil {
@@ -50,19 +111,19 @@ func mainImpl() error {
 	if cache == "" {
 		cache = filepath.Join(root, ".cache")
 	}
-	cat, err := NewCatalog(root, cache, *lang)
+	cat, err := NewCatalog(root, cache, devs, vid.SplitList(*lang), *idet, *probeTimeout)
 	if err != nil {
 		return err
 	}
-	crawl, err := NewCrawler(cat)
+	t := NewTranscodingQueue(cat, *hls)
+	defer t.Close()
+
+	crawl, err := NewCrawler(cat, t)
 	if err != nil {
 		return err
 	}
 	defer crawl.Close()
 
-	t := NewTranscodingQueue(cat)
-	defer t.Close()
-
 	s, err := startServer(*bind, cat, t)
 	if err != nil {
 		return err
@@ -9,7 +9,12 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
 )
 
 func TestMain(m *testing.M) {
@@ -20,6 +25,22 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+// setFakeFFmpeg replaces ffmpeg with a fake replaying the recorded probes in
+// testdata/ for the duration of the test.
+func setFakeFFmpeg(t *testing.T) *ffmpegtest.Fake {
+	mp4, err := os.ReadFile(filepath.Join("testdata", "mp4.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &ffmpegtest.Fake{Probes: map[string][]byte{"b.mp4": mp4}}
+	old := ffmpeg.Default
+	ffmpeg.Default = f
+	t.Cleanup(func() {
+		ffmpeg.Default = old
+	})
+	return f
+}
+
 func tmpDir(t *testing.T) (string, func()) {
 	d, err := os.MkdirTemp("", "serve-mp4")
 	if err != nil {
@@ -31,3 +52,16 @@ func tmpDir(t *testing.T) (string, func()) {
 		}
 	}
 }
+
+// waitFor waits until cond returns true, and fails the test if it takes more
+// than a few seconds, e.g. when a transcoding hangs.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
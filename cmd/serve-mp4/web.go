@@ -6,6 +6,7 @@ package main
 
 import (
 	"encoding/base64"
+	"fmt"
 	"html/template"
 	"io"
 	"log"
@@ -36,12 +37,43 @@ func startServer(bind string, c Catalog, t TranscodingQueue) (Server, error) {
 	}
 	casticon := []byte(castIcon)
 	chromeOSicon := []byte(chromeOSIcon)
+	screenicon := []byte(screenIcon)
 	vlcicon := []byte(vlcIcon)
 
-	listing, err := template.New("listing").Parse(listingRaw)
+	// Not all systems know about HLS, WebVTT, WebM and WebP.
+	if err = mime.AddExtensionType(".m3u8", "application/vnd.apple.mpegurl"); err != nil {
+		return nil, err
+	}
+	if err = mime.AddExtensionType(".m4s", "video/iso.segment"); err != nil {
+		return nil, err
+	}
+	if err = mime.AddExtensionType(".vtt", "text/vtt; charset=utf-8"); err != nil {
+		return nil, err
+	}
+	if err = mime.AddExtensionType(".webm", "video/webm"); err != nil {
+		return nil, err
+	}
+	if err = mime.AddExtensionType(".webp", "image/webp"); err != nil {
+		return nil, err
+	}
+
+	listing, err := template.New("listing").Funcs(templateFuncs).Parse(listingRaw)
 	if err != nil {
 		return nil, err
 	}
+	entry, err := template.New("entry").Funcs(templateFuncs).Parse(entryRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each device gets its own routes; make sure they don't collide.
+	reserved := map[string]bool{"browse": true, "debug": true, "entry": true, "metadata": true, "preview": true, "raw": true, "subtitles": true, "thumbnails": true, "transcode": true}
+	for _, v := range c.Devices() {
+		if n := urlName(v); reserved[n] {
+			return nil, fmt.Errorf("device %s conflicts with /%s/", v, n)
+		}
+		reserved[urlName(v)] = true
+	}
 
 	ln, err := net.Listen("tcp", bind)
 	if err != nil {
@@ -52,6 +84,7 @@ func startServer(bind string, c Catalog, t TranscodingQueue) (Server, error) {
 		t:       t,
 		h:       http.Server{Addr: ln.Addr().String()},
 		listing: listing,
+		entry:   entry,
 	}
 
 	// Routing.
@@ -60,18 +93,25 @@ func startServer(bind string, c Catalog, t TranscodingQueue) (Server, error) {
 	m.HandleFunc("/cast.svg", serveStatic(casticon))
 	m.HandleFunc("/chromeos.svg", serveStatic(chromeOSicon))
 	m.HandleFunc("/favicon.ico", serveStatic(favicon))
+	m.HandleFunc("/screen.svg", serveStatic(screenicon))
 	m.HandleFunc("/spinner.gif", serveStatic(spinner))
 	m.HandleFunc("/vlc.svg", serveStatic(vlcicon))
 	// Retrieval
-	m.HandleFunc("/chromecast/", s.serveChromeCast)
-	m.HandleFunc("/chromeos/", s.serveChromeOS)
+	for _, v := range c.Devices() {
+		m.HandleFunc("/"+urlName(v)+"/", s.serveTranscoded(v))
+	}
 	m.HandleFunc("/raw/", s.serveRaw)
+	m.HandleFunc("/subtitles/", s.serveSubtitles)
+	m.HandleFunc("/thumbnails/", s.serveThumbnails)
+	m.HandleFunc("/preview/", s.servePreview)
 	m.HandleFunc("/metadata/", s.serveMetadata)
+	m.HandleFunc("/entry/", s.serveEntry)
 	m.HandleFunc("/browse/", s.serveBrowse)
 	m.HandleFunc("/", serveRoot)
 	// Action
-	m.HandleFunc("/transcode/chromecast/", s.transcodeChromeCast)
-	m.HandleFunc("/transcode/chromeos/", s.transcodeChromeOS)
+	for _, v := range c.Devices() {
+		m.HandleFunc("/transcode/"+urlName(v)+"/", s.doTranscode(v))
+	}
 	m.HandleFunc("/debug", webstack.SnapshotHandler)
 	// Profiling
 	m.HandleFunc("/debug/pprof/", pprof.Index)
@@ -95,6 +135,7 @@ type server struct {
 	t       TranscodingQueue
 	h       http.Server
 	listing *template.Template
+	entry   *template.Template
 }
 
 func (s *server) Addr() string {
@@ -158,43 +199,113 @@ func (s *server) serveBrowse(w http.ResponseWriter, req *http.Request) {
 		ShouldRefresh bool
 		Directory     *Directory
 		Rel           string
+		Devices       []vid.Device
 	}{
 		Title:         "serve-mp4",
 		ShouldRefresh: d.StillLoading(),
 		Directory:     d,
 		Rel:           rel,
+		Devices:       s.c.Devices(),
 	}
 	if err := s.listing.Execute(w, data); err != nil {
 		log.Printf("root template: %v", err)
 	}
 }
 
-func (s *server) serveChromeOS(w http.ResponseWriter, req *http.Request) {
-	s.serveTranscoded(w, req, "/chromeos/", vid.ChromeOS)
-}
-
-// serveChromeCast handles when the user intents to stream to a ChromeCast.
+// serveTranscoded handles when the user intents to stream to a device, e.g. a
+// ChromeCast.
 //
 // In practice, we could make a full app? It's very geared towards Android/iOS.
 // https://developers.google.com/cast/docs/caf_receiver_overview
 //
 // It's 5$ to get the ID: https://cast.google.com/publish/#/signup
-func (s *server) serveChromeCast(w http.ResponseWriter, req *http.Request) {
-	s.serveTranscoded(w, req, "/chromecast/", vid.ChromeCast)
+func (s *server) serveTranscoded(v vid.Device) http.HandlerFunc {
+	prefix := "/" + urlName(v) + "/"
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "GET" {
+			http.Error(w, "GET only", http.StatusMethodNotAllowed)
+			return
+		}
+		rel := req.URL.Path[len(prefix):]
+		// The challenge here is that we can't find the item based on the path.
+		if filepath.Clean(rel) != rel || strings.HasPrefix(rel, "..") {
+			log.Printf("Invalid path %q", rel)
+			http.Error(w, "Invalid path", 400)
+			return
+		}
+		if filepath.Ext(filepath.Dir(rel)) == vid.HLSSuffix {
+			// The Cast receiver fetches HLS streams with CORS. The playlist grows
+			// while transcoding, so it must be fetched again.
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+			if filepath.Base(rel) == vid.HLSPlaylist {
+				w.Header().Set("Cache-Control", "no-cache")
+			}
+		}
+		serveFile(w, req, filepath.Join(s.c.CacheDir(), v.String(), rel))
+	}
 }
 
-func (s *server) serveTranscoded(w http.ResponseWriter, req *http.Request, prefix string, v vid.Device) {
+// serveSubtitles serves the WebVTT files from the cache, including sidecar
+// subtitle files converted on demand.
+//
+// The Cast receiver fetches text tracks with CORS, so the headers must allow
+// it.
+func (s *server) serveSubtitles(w http.ResponseWriter, req *http.Request) {
 	if req.Method != "GET" {
 		http.Error(w, "GET only", http.StatusMethodNotAllowed)
 		return
 	}
+	const prefix = "/subtitles/"
 	rel := req.URL.Path[len(prefix):]
-	// The challenge here is that we can't find the item based on the path.
-	if filepath.Clean(rel) != rel {
+	if filepath.Clean(rel) != rel || strings.HasPrefix(rel, "..") || filepath.Ext(rel) != ".vtt" {
 		log.Printf("Invalid path %q", rel)
 		http.Error(w, "Invalid path", 400)
+		return
+	}
+	p, err := s.c.LookupSubtitle(req.Context(), rel)
+	if err != nil {
+		log.Printf("%q: %v", rel, err)
+		http.Error(w, "Not found", 404)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	serveFile(w, req, p)
+}
+
+// serveThumbnails serves the poster thumbnails, the seek preview sprite
+// sheets and their WebVTT tracks from the cache.
+//
+// The players fetch thumbnail tracks with CORS, like subtitles.
+func (s *server) serveThumbnails(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, "GET only", http.StatusMethodNotAllowed)
+		return
+	}
+	const prefix = "/" + thumbnailsPrefix
+	rel := req.URL.Path[len(prefix):]
+	if ext := filepath.Ext(rel); filepath.Clean(rel) != rel || strings.HasPrefix(rel, "..") || (ext != ".jpg" && ext != ".vtt") {
+		log.Printf("Invalid path %q", rel)
+		http.Error(w, "Invalid path", 400)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	serveFile(w, req, filepath.Join(s.c.CacheDir(), thumbnailsPrefix, filepath.FromSlash(rel)))
+}
+
+// servePreview serves the animated previews generated in the background.
+func (s *server) servePreview(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, "GET only", http.StatusMethodNotAllowed)
+		return
+	}
+	const prefix = "/preview/"
+	rel := req.URL.Path[len(prefix):]
+	if filepath.Clean(rel) != rel || strings.HasPrefix(rel, "..") || filepath.Ext(rel) != "."+vid.WEBPWebPreview.ToContainer() {
+		log.Printf("Invalid path %q", rel)
+		http.Error(w, "Invalid path", 400)
+		return
 	}
-	serveFile(w, req, filepath.Join(s.c.CacheDir(), v.String(), rel))
+	serveFile(w, req, filepath.Join(s.c.CacheDir(), vid.WEBPWebPreview.String(), filepath.FromSlash(rel)))
 }
 
 func (s *server) serveRaw(w http.ResponseWriter, req *http.Request) {
@@ -213,6 +324,38 @@ func (s *server) serveRaw(w http.ResponseWriter, req *http.Request) {
 	serveFile(w, req, e.srcFile())
 }
 
+// serveEntry serves the detail page of one Entry, including its chapters.
+func (s *server) serveEntry(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, "GET only", http.StatusMethodNotAllowed)
+		return
+	}
+	const prefix = "/entry/"
+	rel := req.URL.Path[len(prefix):]
+	e := s.c.LookupEntry(rel)
+	if e == nil {
+		log.Printf("no item %s", rel)
+		http.Error(w, "Not found", 404)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "private")
+	data := struct {
+		Title string
+		Dir   string
+		Entry *Entry
+		Info  *vid.Info
+	}{
+		Title: filepath.Base(rel),
+		Dir:   rel[:strings.LastIndexByte(rel, '/')+1],
+		Entry: e,
+		Info:  e.Info(),
+	}
+	if err := s.entry.Execute(w, data); err != nil {
+		log.Printf("entry template: %v", err)
+	}
+}
+
 func (s *server) serveMetadata(w http.ResponseWriter, req *http.Request) {
 	if req.Method != "GET" {
 		http.Error(w, "GET only", http.StatusMethodNotAllowed)
@@ -239,60 +382,80 @@ func (s *server) serveMetadata(w http.ResponseWriter, req *http.Request) {
 
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	w.Header().Set("Cache-Control", "public, max-age=86400") // 24*60*60
-	pretty.Fprintf(w, "%# v\n", v)
+	for _, d := range s.c.Devices() {
+		p, err := d.Plan(v)
+		if err != nil {
+			fmt.Fprintf(w, "%s: %v\n", d, err)
+			continue
+		}
+		fmt.Fprintf(w, "%s", p)
+	}
+	pretty.Fprintf(w, "\n%# v\n", v)
 }
 
 // Action
 
-func (s *server) transcodeChromeCast(w http.ResponseWriter, req *http.Request) {
-	s.doTranscode(w, req, "/transcode/chromecast/", vid.ChromeCast)
-}
+func (s *server) doTranscode(v vid.Device) http.HandlerFunc {
+	prefix := "/transcode/" + urlName(v) + "/"
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" {
+			http.Error(w, "POST only", http.StatusMethodNotAllowed)
+			return
+		}
+		if req.Referer() == "" {
+			http.Error(w, "Bad referer", http.StatusMethodNotAllowed)
+			return
+		}
+		// TODO(maruel): Assert the referrer is from the same host.
+		rel := req.URL.Path[len(prefix):]
+		e := s.c.LookupEntry(rel)
+		if e == nil {
+			log.Printf("no item %s", rel)
+			http.Error(w, "Not found", 404)
+			return
+		}
+		if e.IsCached(v) {
+			log.Printf("no item %s", rel)
+			http.Error(w, "Already transcoded", 400)
+			return
+		}
+		if e.IsTranscoding() {
+			log.Printf("still transcoding %s", rel)
+			http.Error(w, "Already transcoding", 400)
+			return
+		}
+		if v := e.Info(); v == nil {
+			log.Printf("Failed to process %q", rel)
+			http.Error(w, "Failed to process", 400)
+			return
+		}
 
-func (s *server) transcodeChromeOS(w http.ResponseWriter, req *http.Request) {
-	s.doTranscode(w, req, "/transcode/chromeos/", vid.ChromeOS)
+		// At that point, always redirect to the referer.
+		defer http.Redirect(w, req, req.Referer(), http.StatusFound)
+		s.t.Transcode(v, e)
+	}
 }
 
-func (s *server) doTranscode(w http.ResponseWriter, req *http.Request, prefix string, v vid.Device) {
-	if req.Method != "POST" {
-		http.Error(w, "POST only", http.StatusMethodNotAllowed)
-		return
-	}
-	if req.Referer() == "" {
-		http.Error(w, "Bad referer", http.StatusMethodNotAllowed)
-		return
-	}
-	// TODO(maruel): Assert the referrer is from the same host.
-	rel := req.URL.Path[len(prefix):]
-	e := s.c.LookupEntry(rel)
-	if e == nil {
-		log.Printf("no item %s", rel)
-		http.Error(w, "Not found", 404)
-		return
-	}
-	if e.IsCached(v) {
-		log.Printf("no item %s", rel)
-		http.Error(w, "Already transcoded", 400)
-		return
-	}
-	if e.IsTranscoding() {
-		log.Printf("still transcoding %s", rel)
-		http.Error(w, "Already transcoding", 400)
-		return
-	}
-	if v := e.Info(); v == nil {
-		log.Printf("Failed to process %q", rel)
-		http.Error(w, "Failed to process", 400)
-		return
-	}
+// templateFuncs are the functions available in the templates.
+var templateFuncs = template.FuncMap{"icon": deviceIcon, "urlName": urlName}
 
-	// At that point, always redirect to the referer.
-	defer http.Redirect(w, req, req.Referer(), http.StatusFound)
-	s.t.Transcode(v, e)
+// deviceIcon returns the URL of the icon representing the device.
+func deviceIcon(v vid.Device) string {
+	switch v {
+	case vid.ChromeCast, vid.ChromeCastUltra, vid.AndroidTV:
+		return "/cast.svg"
+	case vid.ChromeOS:
+		return "/chromeos.svg"
+	default:
+		return "/screen.svg"
+	}
 }
 
 func serveFile(w http.ResponseWriter, req *http.Request, path string) {
 	w.Header().Set("Content-Type", mime.TypeByExtension(filepath.Ext(path)))
-	w.Header().Set("Cache-Control", "public, max-age=86400") // 24*60*60
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "public, max-age=86400") // 24*60*60
+	}
 	f, err := os.Open(path)
 	if err != nil {
 		http.Error(w, "broken", 404)
@@ -8,13 +8,17 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"html/template"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/maruel/serve-mp4/vid"
 )
 
 func TestWeb(t *testing.T) {
@@ -22,6 +26,7 @@ func TestWeb(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	fake := setFakeFFmpeg(t)
 
 	d, f := tmpDir(t)
 	defer f()
@@ -33,11 +38,12 @@ func TestWeb(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	c, err := NewCatalog(d, d, "fre")
+	c, err := NewCatalog(d, d, []vid.Device{vid.ChromeCast, vid.ChromeOS, vid.AndroidTV}, []string{"fre"}, false, time.Minute)
 	if err != nil {
 		t.Fatal(err)
 	}
-	crawl, err := NewCrawler(c)
+	tq := NewTranscodingQueue(c, false)
+	crawl, err := NewCrawler(c, tq)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -46,7 +52,6 @@ func TestWeb(t *testing.T) {
 			t.Fatal(err)
 		}
 	}()
-	tq := NewTranscodingQueue(c)
 	s, err := startServer(":0", c, tq)
 	if err != nil {
 		t.Fatal(err)
@@ -60,35 +65,167 @@ func TestWeb(t *testing.T) {
 	parts := strings.Split(s.Addr(), ":")
 	port := parts[len(parts)-1]
 
-	urls := []string{"/", "/browse/", "/browse/a", "/browse/a/", "/metadata/a/b.mp4", "/raw/a/b.mp4"}
+	urls := []string{"/", "/browse/", "/browse/a", "/browse/a/", "/metadata/a/b.mp4", "/entry/a/b.mp4", "/raw/a/b.mp4"}
 	for _, url := range urls {
 		get(t, port, url)
 	}
 
 	e := c.LookupEntry("a/b.mp4")
 	post(t, port, "/transcode/chromecast/a/b.mp4")
-	// Wait for transcoding to finish.
-	for e.IsTranscoding() {
-		time.Sleep(time.Microsecond)
-	}
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
 	get(t, port, "/chromecast/a/b.mp4")
 	get(t, port, "/browse/a/")
 
 	post(t, port, "/transcode/chromeos/a/b.mp4")
-	// Wait for transcoding to finish.
-	for e.IsTranscoding() {
-		time.Sleep(time.Microsecond)
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
+	// The MPEG-4 part 2 video is re-encoded, so WebM is used.
+	if p := e.Path(vid.ChromeOS); p != "a/b.webm" {
+		t.Fatalf("unexpected path %q", p)
 	}
-	get(t, port, "/chromeos/a/b.mp4")
+	get(t, port, "/chromeos/a/b.webm")
 	get(t, port, "/browse/a/")
+
+	post(t, port, "/transcode/androidtv/a/b.mp4")
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
+	get(t, port, "/androidtv/a/b.mp4")
+
+	// Thumbnails are generated in the background once nothing is transcoding.
+	waitFor(t, "the thumbnails", func() bool {
+		return e.PosterURL() != "" && e.SpriteURL() != "" && e.PreviewURL() != ""
+	})
+	get(t, port, e.PosterURL())
+	get(t, port, e.SpriteURL())
+	get(t, port, "/thumbnails/a/b.sprite.jpg")
+	if u := e.PreviewURL(); u != "/preview/a/b.webp" {
+		t.Fatalf("unexpected preview %q", u)
+	}
+	get(t, port, "/preview/a/b.webp")
+	if b := get(t, port, "/browse/a/"); !strings.Contains(b, `data-preview="/preview/a/b.webp"`) {
+		t.Fatalf("expected the preview in the listing:\n%s", b)
+	}
+	get(t, port, "/entry/a/b.mp4")
+	if r := fake.Runs(); len(r) != 6 {
+		t.Fatalf("expected 6 ffmpeg runs, got %q", r)
+	}
+}
+
+func TestWeb_hls(t *testing.T) {
+	setFakeFFmpeg(t)
+	d, f := tmpDir(t)
+	defer f()
+	a := filepath.Join(d, "a")
+	if err := os.Mkdir(a, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(a, "b.mp4"), []byte("a"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCatalog(d, filepath.Join(d, ".cache"), []vid.Device{vid.ChromeCast}, []string{"fre"}, false, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tq := NewTranscodingQueue(c, true)
+	crawl, err := NewCrawler(c, tq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = crawl.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	s, err := startServer(":0", c, tq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = s.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	parts := strings.Split(s.Addr(), ":")
+	port := parts[len(parts)-1]
+
+	e := c.LookupEntry("a/b.mp4")
+	post(t, port, "/transcode/chromecast/a/b.mp4")
+	waitFor(t, "the transcoding", func() bool { return !e.IsTranscoding() })
+	const playlist = "/chromecast/a/b.hls/index.m3u8"
+	if u := e.HLSURL(vid.ChromeCast); u != playlist {
+		t.Fatalf("unexpected HLS URL %q", u)
+	}
+	if b := get(t, port, "/browse/a/"); !strings.Contains(b, `<a href="`+playlist+`"`) {
+		t.Fatalf("expected the HLS stream in the listing:\n%s", b)
+	}
+	resp, err := http.Get("http://localhost:" + port + playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "#EXT-X-PLAYLIST-TYPE:VOD\n") {
+		t.Fatalf("unexpected playlist:\n%s", b)
+	}
+	want := http.Header{
+		"Access-Control-Allow-Origin": {"*"},
+		"Cache-Control":               {"no-cache"},
+		"Content-Type":                {"application/vnd.apple.mpegurl"},
+	}
+	for k, v := range want {
+		if got := resp.Header.Values(k); !reflect.DeepEqual(got, v) {
+			t.Errorf("unexpected %s: %q", k, got)
+		}
+	}
+	get(t, port, "/chromecast/a/b.hls/init.mp4")
+	get(t, port, "/chromecast/a/b.hls/00000.m4s")
+	get(t, port, "/chromecast/a/b.mp4")
+}
+
+func TestEntryTemplate(t *testing.T) {
+	tmpl, err := template.New("entry").Funcs(templateFuncs).Parse(entryRaw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Entry{
+		Rel:     "a/b.mkv",
+		devices: []vid.Device{vid.ChromeCast, vid.ChromeOS},
+		cached:  map[vid.Device]bool{vid.ChromeCast: true},
+		info: &vid.Info{
+			Chapters: []vid.Chapter{
+				{Start: 0, End: 90 * time.Second, Title: "Intro"},
+				{Start: 90 * time.Second, End: 150 * time.Second},
+			},
+		},
+	}
+	data := struct {
+		Title string
+		Dir   string
+		Entry *Entry
+		Info  *vid.Info
+	}{Title: "b.mkv", Dir: "a/", Entry: e, Info: e.info}
+	b := bytes.Buffer{}
+	if err = tmpl.Execute(&b, data); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<a href="/chromecast/a/b.mp4#t=0">Intro</a>`,
+		`<a href="/chromecast/a/b.mp4#t=90">1m30s</a>`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Fatalf("expected %q in:\n%s", want, b.String())
+		}
+	}
 }
 
-func get(t *testing.T, port, url string) {
+func get(t *testing.T, port, url string) string {
 	resp, err := http.DefaultClient.Get(fmt.Sprintf("http://localhost:%s%s", port, url))
 	if err != nil {
 		t.Fatal(err)
 	}
-	if _, err = io.ReadAll(resp.Body); err != nil {
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
 		t.Fatal(err)
 	}
 	if err = resp.Body.Close(); err != nil {
@@ -97,6 +234,7 @@ func get(t *testing.T, port, url string) {
 	if resp.StatusCode != 200 {
 		t.Fatalf("%s: %d", url, resp.StatusCode)
 	}
+	return string(b)
 }
 
 func post(t *testing.T, port, url string) {
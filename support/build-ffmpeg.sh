@@ -68,6 +68,7 @@ function prerequisites {
     libxcb-shm0-dev \
     libxcb-xfixes0-dev \
     libxcb1-dev \
+    libzimg-dev \
     zlib1g-dev
 }
 
@@ -230,6 +231,7 @@ function install_ffmpeg {
       --enable-libvpx \
       --enable-libx264 \
       --enable-libx265 \
+      --enable-libzimg \
       --enable-nonfree
       # TODO(maruel): Fix
       #--enable-libsvtav1
@@ -6,14 +6,17 @@
 package ffmpeg
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Stream is one stream in the video container as output by ffprobe.
@@ -56,6 +59,10 @@ type Stream struct {
 	IsAvc              string `json:"is_avc"`
 	NalLengthSize      string `json:"nal_length_size"`
 	BitsPerRawSample   string `json:"bits_per_raw_sample"`
+	ColorRange         string `json:"color_range"`
+	ColorSpace         string `json:"color_space"`
+	ColorTransfer      string `json:"color_transfer"`
+	ColorPrimaries     string `json:"color_primaries"`
 
 	// Audio
 	SampleFmt     string `json:"sample_fmt"`
@@ -104,10 +111,39 @@ type ProbeResult struct {
 	Format   Format
 }
 
+// Executor runs the ffprobe and ffmpeg processes.
+//
+// Replace Default to use different executables or to run without them in
+// tests.
+type Executor interface {
+	// Probe runs ffprobe with the arguments and returns its output.
+	Probe(ctx context.Context, args []string) ([]byte, error)
+	// Run runs ffmpeg with the arguments and returns its output.
+	Run(ctx context.Context, args []string) ([]byte, error)
+}
+
+// Default is the Executor used by all the functions in this package.
+var Default Executor = &Commands{FFprobe: "ffprobe", FFmpeg: "ffmpeg"}
+
+// Commands is an Executor running the ffprobe and ffmpeg executables.
+type Commands struct {
+	FFprobe string // Path to ffprobe, or its name to search in PATH.
+	FFmpeg  string // Path to ffmpeg, or its name to search in PATH.
+}
+
+// Probe runs the ffprobe executable.
+func (c *Commands) Probe(ctx context.Context, args []string) ([]byte, error) {
+	return command(ctx, c.FFprobe, args...).CombinedOutput()
+}
+
+// Run runs the ffmpeg executable.
+func (c *Commands) Run(ctx context.Context, args []string) ([]byte, error) {
+	return command(ctx, c.FFmpeg, args...).CombinedOutput()
+}
+
 // Probe runs ffprobe on a file and returns the typed output.
-func Probe(src string, r *ProbeResult) error {
-	c := exec.Command("ffprobe", "-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", "-show_chapters", src)
-	raw, err := c.CombinedOutput()
+func Probe(ctx context.Context, src string, r *ProbeResult) error {
+	raw, err := Default.Probe(ctx, []string{"-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", "-show_chapters", src})
 	if err != nil {
 		return fmt.Errorf("Probe(%s): %v\n%s", src, err, raw)
 	}
@@ -118,10 +154,9 @@ func Probe(src string, r *ProbeResult) error {
 }
 
 // ProbeRaw runs ffprobe on a file and returns the untyped output.
-func ProbeRaw(src string) (map[string]interface{}, error) {
+func ProbeRaw(ctx context.Context, src string) (map[string]interface{}, error) {
 	out := map[string]interface{}{}
-	c := exec.Command("ffprobe", "-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", src)
-	raw, err := c.CombinedOutput()
+	raw, err := Default.Probe(ctx, []string{"-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", src})
 	if err != nil {
 		return nil, fmt.Errorf("ProbeRaw(%s): %v\n%s", src, err, raw)
 	}
@@ -131,9 +166,51 @@ func ProbeRaw(src string) (map[string]interface{}, error) {
 	return out, nil
 }
 
+var reIdet = regexp.MustCompile(`Multi frame detection: TFF:\s*(\d+)\s+BFF:\s*(\d+)\s+Progressive:\s*(\d+)`)
+
+// Idet runs the idet filter on the first frames of a video stream and returns
+// the number of frames detected as top field first, bottom field first and
+// progressive.
+//
+// https://ffmpeg.org/ffmpeg-filters.html#idet
+func Idet(ctx context.Context, src string, index, frames int) (tff, bff, progressive int, err error) {
+	args := []string{
+		"-i", src,
+		"-map", fmt.Sprintf("0:%d", index),
+		"-frames:v", strconv.Itoa(frames),
+		"-filter:v", "idet",
+		"-an",
+		"-f", "null", "-",
+	}
+	out, err := Transcode(ctx, args, nil)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("Idet(%s): %v\n%s", src, err, out)
+	}
+	m := reIdet.FindSubmatch(out)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("Idet(%s): no result", src)
+	}
+	tff, _ = strconv.Atoi(string(m[1]))
+	bff, _ = strconv.Atoi(string(m[2]))
+	progressive, _ = strconv.Atoi(string(m[3]))
+	return tff, bff, progressive, nil
+}
+
+// Progress is the progress information reported periodically by ffmpeg.
+type Progress struct {
+	Frame   int           // Number of frames encoded so far.
+	FPS     float64       // Encoding speed in frames per second.
+	BitRate int64         // Output bit rate in bits/s; 0 if unknown.
+	OutTime time.Duration // Position in the output.
+	Speed   float64       // Encoding speed relative to real time; 0 if unknown.
+	Done    bool          // Last update.
+}
+
 // Transcode calls ffmpeg with the specified arguments, calls back into
 // progress with progress information.
-func Transcode(args []string, progress func(frame int)) ([]byte, error) {
+//
+// When ctx is canceled, ffmpeg and its children are killed.
+func Transcode(ctx context.Context, args []string, progress func(p Progress)) ([]byte, error) {
 	cmd := []string{
 		"-hide_banner",
 	}
@@ -148,16 +225,27 @@ func Transcode(args []string, progress func(frame int)) ([]byte, error) {
 		cmd = append(cmd, "-progress", fmt.Sprintf("http://%s/progress", ln.Addr().String()))
 		defer s.Close()
 	}
-	return exec.Command("ffmpeg", append(cmd, args...)...).CombinedOutput()
+	return Default.Run(ctx, append(cmd, args...))
+}
+
+// command returns a command that is killed along its process group when ctx
+// is canceled.
+func command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	c := exec.CommandContext(ctx, name, args...)
+	setProcessGroup(c)
+	// Do not hang if a grand child process keeps stdout open.
+	c.WaitDelay = 5 * time.Second
+	return c
 }
 
 //
 
-type progressHandler func(frame int)
+type progressHandler func(p Progress)
 
 func (p progressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	defer req.Body.Close()
 	defer w.WriteHeader(200)
+	var cur Progress
 	l := ""
 	b := make([]byte, 1)
 	for {
@@ -171,14 +259,38 @@ func (p progressHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 		parts := strings.SplitN(l, "=", 2)
 		if len(parts) == 2 {
+			// Values are "N/A" when unknown; keep the last value in this case.
+			v := strings.TrimSpace(parts[1])
 			switch parts[0] {
 			case "frame":
-				if i, err := strconv.Atoi(parts[1]); err == nil {
-					p(i)
+				if i, err := strconv.Atoi(v); err == nil {
+					cur.Frame = i
 				} else {
 					log.Printf("%s: %v", l, err)
 				}
-			case "fps", "stream_0_0_q", "bitrate", "total_size", "out_time_ms", "out_time", "dup_frames", "drop_frames", "speed", "progress", "out_time_us":
+			case "fps":
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					cur.FPS = f
+				}
+			case "bitrate":
+				// e.g. "1234.5kbits/s".
+				if f, err := strconv.ParseFloat(strings.TrimSuffix(v, "kbits/s"), 64); err == nil {
+					cur.BitRate = int64(f * 1000)
+				}
+			case "out_time_us":
+				if i, err := strconv.ParseInt(v, 10, 64); err == nil && i >= 0 {
+					cur.OutTime = time.Duration(i) * time.Microsecond
+				}
+			case "speed":
+				// e.g. "1.5x".
+				if f, err := strconv.ParseFloat(strings.TrimSuffix(v, "x"), 64); err == nil {
+					cur.Speed = f
+				}
+			case "progress":
+				// Terminates each block; "continue" or "end".
+				cur.Done = v == "end"
+				p(cur)
+			case "stream_0_0_q", "total_size", "out_time_ms", "out_time", "dup_frames", "drop_frames":
 				// Known lines. We could handle these if desired.
 			default:
 				// Unknown line. Not a big deal.
@@ -0,0 +1,11 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ffmpeg
+
+import "os/exec"
+
+// setProcessGroup is a no-op; exec.CommandContext kills the process.
+func setProcessGroup(c *exec.Cmd) {
+}
@@ -0,0 +1,287 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package ffmpegtest provides a fake ffmpeg.Executor to test code using ffprobe
+// and ffmpeg without them installed.
+package ffmpegtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+)
+
+// Fake is an ffmpeg.Executor that doesn't run any process. It is meant to test the
+// whole pipeline without ffmpeg installed.
+//
+// ffprobe replays the recorded JSON output. ffmpeg simulates progress when
+// requested, then writes placeholder output files. Probing an output file
+// returns the streams described by the ffmpeg arguments that wrote it, unless
+// it was recorded in Probes.
+type Fake struct {
+	// Probes is the recorded ffprobe JSON output keyed by the base name of the
+	// file probed. The key "" is used for files not found.
+	Probes map[string][]byte
+	// Length is the simulated duration of the output; defaults to one minute.
+	Length time.Duration
+	// Steps is the number of progress updates to send; defaults to 3.
+	Steps int
+
+	mu      sync.Mutex
+	runs    [][]string
+	outputs map[string][]byte // ffprobe output for the files written.
+}
+
+// Probe simulates ffprobe on the file in the last argument.
+func (f *Fake) Probe(ctx context.Context, args []string) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, errors.New("fake ffprobe: no argument")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	src := args[len(args)-1]
+	if _, err := os.Stat(src); err != nil {
+		return nil, err
+	}
+	// An explicit recording takes precedence, e.g. to simulate a bad output.
+	if b, ok := f.Probes[filepath.Base(src)]; ok {
+		return b, nil
+	}
+	f.mu.Lock()
+	b, ok := f.outputs[src]
+	f.mu.Unlock()
+	if ok {
+		return b, nil
+	}
+	return f.recorded(src)
+}
+
+// recorded returns the recorded ffprobe output for a source file.
+func (f *Fake) recorded(src string) ([]byte, error) {
+	if b, ok := f.Probes[filepath.Base(src)]; ok {
+		return b, nil
+	}
+	if b, ok := f.Probes[""]; ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("fake ffprobe: no recording for %q", src)
+}
+
+// Run simulates ffmpeg.
+//
+// The output files are the last argument and any argument after "-y". The
+// output also contains an idet summary.
+//
+// An HLS output is written with one segment before the progress updates and
+// completed at the end, like a live stream.
+func (f *Fake) Run(ctx context.Context, args []string) ([]byte, error) {
+	f.mu.Lock()
+	f.runs = append(f.runs, args)
+	f.mu.Unlock()
+	if len(args) == 0 {
+		return nil, errors.New("fake ffmpeg: no argument")
+	}
+	outputs := []string{args[len(args)-1]}
+	if filepath.Ext(outputs[0]) == ".m3u8" {
+		if err := writeHLS(outputs[0], false); err != nil {
+			return nil, err
+		}
+	}
+	for i, a := range args[:len(args)-1] {
+		switch a {
+		case "-i":
+			if _, err := os.Stat(args[i+1]); err != nil {
+				return nil, err
+			}
+		case "-progress":
+			if err := f.progress(ctx, args[i+1]); err != nil {
+				return nil, err
+			}
+		case "-y":
+			if i+1 != len(args)-1 {
+				outputs = append(outputs, args[i+1])
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, o := range outputs {
+		if o == "-" {
+			continue
+		}
+		if filepath.Ext(o) == ".m3u8" {
+			if err := writeHLS(o, true); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		content := "fake"
+		if filepath.Ext(o) == ".vtt" {
+			content = "WEBVTT\n"
+		}
+		if err := os.WriteFile(o, []byte(content), 0o644); err != nil {
+			return nil, err
+		}
+	}
+	if b := f.outputProbe(args); b != nil {
+		f.mu.Lock()
+		if f.outputs == nil {
+			f.outputs = map[string][]byte{}
+		}
+		f.outputs[outputs[0]] = b
+		f.mu.Unlock()
+	}
+	return []byte("Multi frame detection: TFF: 0 BFF: 0 Progressive: 10 Undetermined: 0\n"), nil
+}
+
+// Runs returns the arguments of each ffmpeg invocation so far.
+func (f *Fake) Runs() [][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([][]string(nil), f.runs...)
+}
+
+// writeHLS writes an HLS stream with a single segment, complete if done.
+func writeHLS(playlist string, done bool) error {
+	dir := filepath.Dir(playlist)
+	for _, n := range []string{"init.mp4", "00000.m4s"} {
+		if err := os.WriteFile(filepath.Join(dir, n), []byte("fake"), 0o644); err != nil {
+			return err
+		}
+	}
+	s := "#EXTM3U\n#EXT-X-VERSION:7\n#EXT-X-TARGETDURATION:6\n#EXT-X-PLAYLIST-TYPE:EVENT\n#EXT-X-MAP:URI=\"init.mp4\"\n#EXTINF:6.000000,\n00000.m4s\n"
+	if done {
+		s += "#EXT-X-ENDLIST\n"
+	}
+	return os.WriteFile(playlist, []byte(s), 0o644)
+}
+
+// progress sends progress updates to the URL like ffmpeg does.
+func (f *Fake) progress(ctx context.Context, url string) error {
+	l := f.Length
+	if l == 0 {
+		l = time.Minute
+	}
+	steps := f.Steps
+	if steps == 0 {
+		steps = 3
+	}
+	var b strings.Builder
+	for i := 1; i <= steps; i++ {
+		p := "continue"
+		if i == steps {
+			p = "end"
+		}
+		fmt.Fprintf(&b, "frame=%d\nfps=24.00\nbitrate=1000.0kbits/s\nout_time_us=%d\nspeed=2.0x\nprogress=%s\n", 24*i, int64(l/time.Microsecond)*int64(i)/int64(steps), p)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(b.String()))
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// encoderCodecs maps ffmpeg encoders to the codec names reported by ffprobe.
+var encoderCodecs = map[string]string{
+	"libfdk_aac": "aac",
+	"libopus":    "opus",
+	"libvpx-vp9": "vp9",
+	"libwebp":    "webp",
+	"libx264":    "h264",
+	"libx265":    "hevc",
+}
+
+// outputProbe returns the ffprobe output for the file written by ffmpeg with
+// args, or nil if the streams are not explicitly mapped.
+//
+// The first input can be an output written before. The streams are the ones
+// mapped from it, with the codec selected by "-c:<type>:<n>". The duration is
+// the input's, capped by "-t".
+func (f *Fake) outputProbe(args []string) []byte {
+	var in ffmpeg.ProbeResult
+	var maps []int
+	var duration float64 = -1
+	codecs := map[string]string{}
+	format := ""
+	for i := 0; i+1 < len(args); i++ {
+		switch a := args[i]; {
+		case a == "-i" && in.Streams == nil:
+			f.mu.Lock()
+			b, ok := f.outputs[args[i+1]]
+			f.mu.Unlock()
+			if !ok {
+				var err error
+				if b, err = f.recorded(args[i+1]); err != nil {
+					return nil
+				}
+			}
+			if json.Unmarshal(b, &in) != nil {
+				return nil
+			}
+		case a == "-map" && args[i+1] == "0":
+			for _, s := range in.Streams {
+				maps = append(maps, s.Index)
+			}
+		case a == "-map":
+			n, err := strconv.Atoi(strings.TrimPrefix(args[i+1], "0:"))
+			if err != nil {
+				return nil
+			}
+			maps = append(maps, n)
+		case a == "-t":
+			duration, _ = strconv.ParseFloat(args[i+1], 64)
+		case a == "-f":
+			format = args[i+1]
+		case strings.HasPrefix(a, "-c:"):
+			codecs[a[len("-c:"):]] = args[i+1]
+		}
+	}
+	if len(maps) == 0 {
+		return nil
+	}
+	out := ffmpeg.ProbeResult{Format: in.Format}
+	out.Format.FormatName = format
+	if d, err := strconv.ParseFloat(in.Format.Duration, 64); err == nil && (duration < 0 || d < duration) {
+		duration = d
+	}
+	if duration >= 0 {
+		out.Format.Duration = strconv.FormatFloat(duration, 'f', -1, 64)
+	}
+	count := map[string]int{}
+	for _, n := range maps {
+		for _, s := range in.Streams {
+			if s.Index != n {
+				continue
+			}
+			t := s.CodecType[:1]
+			spec := fmt.Sprintf("%s:%d", t, count[t])
+			count[t]++
+			if c := codecs[spec]; c != "" && c != "copy" {
+				if s.CodecName = encoderCodecs[c]; s.CodecName == "" {
+					s.CodecName = c
+				}
+			}
+			s.Index = len(out.Streams)
+			out.Streams = append(out.Streams, s)
+		}
+	}
+	b, _ := json.Marshal(&out)
+	return b
+}
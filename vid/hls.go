@@ -0,0 +1,155 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+)
+
+// HLSSuffix replaces the extension of a transcoded file to name the directory
+// of its HLS stream.
+const HLSSuffix = ".hls"
+
+// HLSPlaylist is the name of the playlist in the directory of an HLS stream.
+const HLSPlaylist = "index.m3u8"
+
+// hlsSegmentDuration is the target duration of a segment in seconds, as
+// recommended by Apple.
+//
+// https://developer.apple.com/documentation/http-live-streaming/hls-authoring-specification-for-apple-devices
+const hlsSegmentDuration = "6"
+
+// HLSDir returns the directory of the HLS stream written while transcoding to
+// dst.
+func HLSDir(dst string) string {
+	return dst[:len(dst)-len(filepath.Ext(dst))] + HLSSuffix
+}
+
+// HLSStatus returns the number of segments listed in the playlist of the HLS
+// stream in dir, and whether the stream is complete.
+//
+// The segments listed can be served; ffmpeg only adds them to the playlist
+// once they are completely written.
+func HLSStatus(dir string) (int, bool) {
+	b, err := os.ReadFile(filepath.Join(dir, HLSPlaylist))
+	if err != nil {
+		return 0, false
+	}
+	s := string(b)
+	return strings.Count(s, "#EXTINF:"), strings.Contains(s, "#EXT-X-ENDLIST")
+}
+
+// TranscodeHLS transcodes a video file for playback on the device like
+// Transcode, and streams it with HLS while it is being transcoded; see
+// Plan.TranscodeHLS.
+func (d Device) TranscodeHLS(ctx context.Context, src, dst string, v *Info, progress func(p ffmpeg.Progress)) error {
+	plan, err := d.Plan(v)
+	if err != nil {
+		return fmt.Errorf("TranscodeHLS(%s, %s): %v", src, dst, err)
+	}
+	return plan.TranscodeHLS(ctx, src, dst, progress)
+}
+
+// HLSArgs returns the ffmpeg arguments to execute the plan as an HLS stream
+// of fragmented MP4 segments in dir.
+//
+// The playlist is an EVENT one, so it can be played while it is being
+// written.
+func (p *Plan) HLSArgs(src, dir string) []string {
+	muxer := []string{
+		"-hls_time", hlsSegmentDuration,
+		"-hls_playlist_type", "event",
+		"-hls_segment_type", "fmp4",
+		// Segments and playlist updates are written to a temporary file first,
+		// so a partial segment is never served.
+		"-hls_flags", "independent_segments+temp_file",
+		"-hls_segment_filename", filepath.Join(dir, "%05d.m4s"),
+	}
+	// The mp4 muxer options do not apply to the segments.
+	for i := 0; i+1 < len(p.Muxer); i += 2 {
+		if p.Muxer[i] != "-movflags" {
+			muxer = append(muxer, p.Muxer[i], p.Muxer[i+1])
+		}
+	}
+	return p.args(src, filepath.Join(dir, HLSPlaylist), "hls", muxer)
+}
+
+// TranscodeHLS executes the plan like Transcode, but the video can be played
+// while it is being transcoded.
+//
+// The stream is written in HLSDir(dst) first. Once complete, the playlist is
+// marked as VOD and the segments are remuxed into dst, so both can be served.
+// On failure, the stream is deleted.
+//
+// Only mp4 output is supported.
+func (p *Plan) TranscodeHLS(ctx context.Context, src, dst string, progress func(p ffmpeg.Progress)) error {
+	if p.Container != "mp4" {
+		return fmt.Errorf("TranscodeHLS(%s, %s): HLS is not supported for %s", src, dst, p.Container)
+	}
+	dir := HLSDir(dst)
+	// Leftover from an interrupted transcoding.
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("TranscodeHLS(%s, %s): %v", src, dst, err)
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return fmt.Errorf("TranscodeHLS(%s, %s): %v", src, dst, err)
+	}
+	args := p.HLSArgs(src, dir)
+	log.Printf("TranscodeHLS(%s) running: ffmpeg %s", src, strings.Join(args, " "))
+	if out, err := ffmpeg.Transcode(ctx, args, progress); err != nil {
+		log.Printf("TranscodeHLS(%s) = %v\n%s", src, err, out)
+		os.RemoveAll(dir)
+		return fmt.Errorf("TranscodeHLS(%s, %s): %v", src, dst, err)
+	}
+	if err := hlsVOD(dir); err != nil {
+		os.RemoveAll(dir)
+		return fmt.Errorf("TranscodeHLS(%s, %s): %v", src, dst, err)
+	}
+	// The segments are copied as-is; the chapters and the global metadata are
+	// not in the stream, so they are taken from the source.
+	playlist := filepath.Join(dir, HLSPlaylist)
+	args = []string{
+		"-i", playlist,
+		"-i", src,
+		"-f", p.Container,
+		"-movflags", "+faststart",
+		"-map_metadata", "1", "-map_chapters", "1",
+		"-map", "0",
+		"-c", "copy",
+		dst + PartialSuffix,
+	}
+	if err := p.write(ctx, playlist, dst, args, nil); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	return nil
+}
+
+// hlsVOD marks the complete playlist in dir as VOD, so players know it will
+// not change anymore.
+func hlsVOD(dir string) error {
+	p := filepath.Join(dir, HLSPlaylist)
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	s := strings.Replace(string(b), "#EXT-X-PLAYLIST-TYPE:EVENT", "#EXT-X-PLAYLIST-TYPE:VOD", 1)
+	if !strings.Contains(s, "#EXT-X-ENDLIST") {
+		s += "#EXT-X-ENDLIST\n"
+	}
+	// The playlist may be fetched at any time.
+	tmp := p + PartialSuffix
+	if err = os.WriteFile(tmp, []byte(s), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
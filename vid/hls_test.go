@@ -0,0 +1,87 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
+)
+
+func TestPlan_TranscodeHLS(t *testing.T) {
+	v := identify(t, "h264_aac.mkv", "eng")
+	p, err := ChromeCast.Plan(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(filepath.Join("testdata", "h264_aac.mkv.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &ffmpegtest.Fake{Probes: map[string][]byte{"in.mkv": b}}
+	ffmpeg.Default = f
+	d := t.TempDir()
+	src := filepath.Join(d, "in.mkv")
+	if err = os.WriteFile(src, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(d, "out", "in.mp4")
+	dir := HLSDir(dst)
+	if want := filepath.Join(d, "out", "in.hls"); dir != want {
+		t.Fatalf("unexpected HLS directory %q", dir)
+	}
+	// The stream can be played while it is being transcoded.
+	live := false
+	progress := func(ffmpeg.Progress) {
+		n, done := HLSStatus(dir)
+		live = live || (n != 0 && !done)
+	}
+	if err = p.TranscodeHLS(context.Background(), src, dst, progress); err != nil {
+		t.Fatal(err)
+	}
+	if !live {
+		t.Fatal("expected the stream to be available while transcoding")
+	}
+	if n, done := HLSStatus(dir); n != 1 || !done {
+		t.Fatalf("unexpected status %d %t", n, done)
+	}
+	b, err = os.ReadFile(filepath.Join(dir, HLSPlaylist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "#EXT-X-PLAYLIST-TYPE:VOD\n") {
+		t.Fatalf("expected a VOD playlist:\n%s", b)
+	}
+	if _, err = os.Stat(dst); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := f.Runs()
+	if len(runs) != 2 {
+		t.Fatalf("expected 2 runs, got %q", runs)
+	}
+	hls := strings.Join(runs[0], " ")
+	if !strings.Contains(hls, " -f hls -hls_time 6 -hls_playlist_type event -hls_segment_type fmp4 ") || strings.Contains(hls, "-movflags") || !strings.Contains(hls, "-map_chapters 0") {
+		t.Errorf("unexpected HLS args %s", hls)
+	}
+	// The segments are remuxed as-is into the MP4 file.
+	if remux := strings.Join(runs[1], " "); !strings.HasPrefix(remux, "-hide_banner -i "+filepath.Join(dir, HLSPlaylist)+" -i "+src+" ") || !strings.HasSuffix(remux, " -map 0 -c copy "+dst+PartialSuffix) {
+		t.Errorf("unexpected remux args %s", remux)
+	}
+
+	// WebM is not supported.
+	preferWebM(t, ChromeOS)
+	if p, err = ChromeOS.Plan(v); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.TranscodeHLS(context.Background(), src, filepath.Join(d, "out", "in.webm"), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Plan describes how Transcode converts a video for a device.
+//
+// It is decided by Device.Plan().
+type Plan struct {
+	Device    Device
+	Container string        // ffmpeg muxer, e.g. "mp4".
+	Input     []string      // Options applied to the source.
+	Muxer     []string      // Options applied to the output file.
+	Video     StreamPlan    // Selected video stream.
+	Audios    []StreamPlan  // Audio streams, the preferred one first; empty when there's none.
+	Length    time.Duration // Expected duration of the output; 0 if unknown.
+}
+
+// StreamPlan describes how one stream is converted.
+type StreamPlan struct {
+	Index   int      // Stream index in the source.
+	From    string   // Source codec, e.g. "mpeg4".
+	Copy    bool     // The stream is copied as-is.
+	Codec   string   // Output codec as named by ffprobe, e.g. "h264".
+	Encoder string   // ffmpeg encoder, e.g. "libx265"; "" when copied.
+	Options []string // Encoder options.
+	Filters []string // Filters applied before encoding.
+	Lang    string   // Language to tag the output stream with.
+	Title   string   // Title to tag the output audio stream with.
+	Default bool     // Flagged as the default audio stream.
+	Reasons []string // Why the stream is transcoded or filtered.
+}
+
+func (s *StreamPlan) String() string {
+	out := fmt.Sprintf("#%d ", s.Index)
+	if s.Lang != "" {
+		out += s.Lang + " "
+	}
+	if s.Copy {
+		out += "copy " + s.From
+	} else {
+		out += fmt.Sprintf("%s -> %s (%s)", s.From, s.Codec, s.Encoder)
+	}
+	if len(s.Filters) != 0 {
+		out += " filters: " + strings.Join(s.Filters, ",")
+	}
+	if len(s.Reasons) != 0 {
+		out += "; " + strings.Join(s.Reasons, "; ")
+	}
+	return out
+}
+
+// String returns a human readable explanation of the plan.
+func (p *Plan) String() string {
+	out := fmt.Sprintf("%s: %s\n  Video: %s\n", p.Device, p.Container, &p.Video)
+	for i := range p.Audios {
+		out += fmt.Sprintf("  Audio: %s\n", &p.Audios[i])
+	}
+	if len(p.Audios) == 0 {
+		out += "  Audio: none\n"
+	}
+	return out
+}
+
+// Args returns the ffmpeg arguments to execute the plan.
+func (p *Plan) Args(src, dst string) []string {
+	return p.args(src, dst, p.Container, p.Muxer)
+}
+
+// args returns the ffmpeg arguments to execute the plan with the muxer format
+// and its options.
+func (p *Plan) args(src, dst, format string, muxer []string) []string {
+	args := append(append([]string{}, p.Input...), "-i", src, "-f", format)
+	args = append(args, muxer...)
+	args = append(args, "-map", fmt.Sprintf("0:%d", p.Video.Index))
+	for i := range p.Audios {
+		args = append(args, "-map", fmt.Sprintf("0:%d", p.Audios[i].Index))
+	}
+	args = append(args, p.Video.args("v:0")...)
+	for i := range p.Audios {
+		args = append(args, p.Audios[i].args(fmt.Sprintf("a:%d", i))...)
+	}
+	if len(p.Audios) == 0 {
+		args = append(args, "-an")
+	}
+	return append(args, dst)
+}
+
+// args returns the ffmpeg arguments for the output stream t, e.g. "v:0" or
+// "a:1".
+func (s *StreamPlan) args(t string) []string {
+	enc := s.Encoder
+	if s.Copy {
+		enc = "copy"
+	}
+	args := []string{"-c:" + t, enc}
+	// Options are flag and value pairs. Each flag gets the output stream
+	// specifier, otherwise it would apply to all the streams of the type,
+	// e.g. "-b:a" to every audio track.
+	for i := 0; i+1 < len(s.Options); i += 2 {
+		f := s.Options[i]
+		if j := strings.IndexByte(f, ':'); j != -1 {
+			f = f[:j]
+		}
+		args = append(args, f+":"+t, s.Options[i+1])
+	}
+	if len(s.Filters) != 0 {
+		args = append(args, "-filter:"+t, strings.Join(s.Filters, ","))
+	}
+	switch s.Lang {
+	case "", "und":
+	default:
+		args = append(args, "-metadata:s:"+t, "language="+s.Lang)
+	}
+	if t[0] == 'a' {
+		// The stream metadata and flags are copied from the source. Overwrite
+		// them so a title describing the source codec is removed and a single
+		// track is the default.
+		args = append(args, "-metadata:s:"+t, "title="+s.Title)
+		if s.Default {
+			args = append(args, "-disposition:"+t, "default")
+		} else {
+			args = append(args, "-disposition:"+t, "0")
+		}
+	}
+	return args
+}
+
+// Plan decides how to transcode the video for playback on the device.
+//
+// v must have been returned by Identify().
+func (d Device) Plan(v *Info) (*Plan, error) {
+	p := d.Profile()
+	if p == nil {
+		return nil, fmt.Errorf("Plan(%s): unknown device", d)
+	}
+	c := d.Container(v)
+	out := &Plan{
+		Device:    d,
+		Container: c,
+		Video:     StreamPlan{Index: v.VideoIndex, From: v.VideoCodec},
+		Length:    v.Length,
+	}
+	switch c {
+	case "mp4":
+		// https://trac.ffmpeg.org/wiki/Encode/AAC#ProgressiveDownload
+		out.Muxer = append(out.Muxer, "-movflags", "+faststart")
+	case "webm":
+		// Write the index before the clusters so the player doesn't have to
+		// fetch the end of the file to seek.
+		out.Muxer = append(out.Muxer, "-cues_to_front", "1")
+	}
+	if d == WEBPWebPreview {
+		out.planPreview(v)
+		return out, nil
+	}
+	// Keep the title and the chapter markers.
+	out.Muxer = append(out.Muxer, "-map_metadata", "0", "-map_chapters", "0")
+	out.planVideo(p, v)
+	if v.AudioCodec == "" {
+		return out, nil
+	}
+	// The preferred track goes first, since some players ignore the default
+	// flag.
+	for i := range v.Audios {
+		if a := &v.Audios[i]; a.Index == v.AudioIndex {
+			out.Audios = append(out.Audios, out.planAudio(p, a))
+			out.Audios[0].Default = true
+		}
+	}
+	if p.AllAudio {
+		for i := range v.Audios {
+			if a := &v.Audios[i]; a.Index != v.AudioIndex {
+				out.Audios = append(out.Audios, out.planAudio(p, a))
+			}
+		}
+	}
+	return out, nil
+}
+
+// VerifyError is returned when a transcoded file doesn't match its Plan.
+type VerifyError struct {
+	Reasons []string
+}
+
+func (e *VerifyError) Error() string {
+	return "verification failed: " + strings.Join(e.Reasons, "; ")
+}
+
+// Verify probes the file transcoded with the plan and compares its duration,
+// streams and codecs with the plan.
+//
+// Returns a *VerifyError if they don't match.
+func (p *Plan) Verify(ctx context.Context, dst string) error {
+	out, err := Identify(ctx, dst, nil)
+	if err != nil {
+		return &VerifyError{Reasons: []string{err.Error()}}
+	}
+	var reasons []string
+	if len(out.Videos) != 1 {
+		reasons = append(reasons, fmt.Sprintf("%d video streams, expected 1", len(out.Videos)))
+	} else if out.VideoCodec != p.Video.Codec {
+		reasons = append(reasons, fmt.Sprintf("video is %s, expected %s", out.VideoCodec, p.Video.Codec))
+	}
+	if len(out.Audios) != len(p.Audios) {
+		reasons = append(reasons, fmt.Sprintf("%d audio streams, expected %d", len(out.Audios), len(p.Audios)))
+	} else {
+		for i := range p.Audios {
+			if c := out.Audios[i].Codec; c != p.Audios[i].Codec {
+				reasons = append(reasons, fmt.Sprintf("audio #%d is %s, expected %s", i, c, p.Audios[i].Codec))
+			}
+		}
+	}
+	if p.Length > 0 {
+		// Streams rarely end at the exact same time.
+		margin := p.Length / 100
+		if margin < 2*time.Second {
+			margin = 2 * time.Second
+		}
+		if d := out.Length - p.Length; d > margin || d < -margin {
+			reasons = append(reasons, fmt.Sprintf("duration %s, expected %s", out.Length, p.Length))
+		}
+	}
+	if len(reasons) != 0 {
+		return &VerifyError{Reasons: reasons}
+	}
+	return nil
+}
+
+// planPreview plans a short animated preview without audio.
+//
+// Like thumbnails, the frames are deinterlaced and tone mapped to SDR.
+func (p *Plan) planPreview(v *Info) {
+	// The source is sped up and cut; the output duration is not checked.
+	p.Length = 0
+	p.Input = []string{"-itsoffset", "1:00", "-itsscale", "2"}
+	p.Muxer = append(p.Muxer, "-t", "30", "-loop", "1")
+	s := &p.Video
+	s.Codec = "webp"
+	s.Encoder = "libwebp"
+	s.Options = []string{
+		"-lossless", "0", "-compression_level", "3",
+		"-s", "320:-1",
+		// "-preset", "default",
+	}
+	s.Filters = append(thumbnailFilters(v), "fps=fps=2")
+	s.Reasons = []string{"preview"}
+	if v.Interlaced {
+		s.Reasons = append(s.Reasons, "interlaced")
+	}
+	if v.HDR != "" {
+		s.Reasons = append(s.Reasons, v.HDR+" is tone mapped to SDR")
+	}
+}
+
+// planVideo decides whether to copy or transcode the video stream.
+func (p *Plan) planVideo(prof *Profile, v *Info) {
+	s := &p.Video
+	// Any filter forces a video transcode.
+	if v.Interlaced {
+		// Copying would forward combed video.
+		s.Filters = append(s.Filters, deinterlace)
+		s.Reasons = append(s.Reasons, "interlaced")
+	}
+	if f := prof.fpsFilter(v); f != "" {
+		s.Filters = append(s.Filters, f)
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%.3g fps is over %g", v.FrameRate.Float(), prof.MaxFrameRate))
+	}
+	if f := prof.scaleFilter(v); f != "" {
+		// Scale before tone mapping, which is expensive.
+		s.Filters = append(s.Filters, f)
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%dx%d is over %dx%d", v.Width, v.Height, prof.MaxWidth, prof.MaxHeight))
+	}
+	if v.HDR != "" && !prof.HDR {
+		// The device can't display HDR; it would look washed out.
+		s.Filters = append(s.Filters, tonemapSDR)
+		s.Reasons = append(s.Reasons, v.HDR+" is tone mapped to SDR")
+	}
+	reason := prof.rejectVideo(v)
+	if reason == "" && !canMux(p.Container, v.VideoCodec) {
+		reason = fmt.Sprintf("%s can't be stored in %s", v.VideoCodec, p.Container)
+	}
+	if reason != "" {
+		s.Reasons = append(s.Reasons, reason)
+	}
+	if reason == "" && len(s.Filters) == 0 {
+		// Video Copy.
+		s.Copy = true
+		s.Codec = v.VideoCodec
+		if v.VideoCodec == "hevc" && p.Container == "mp4" {
+			// Required for playback of h265 in mp4 on Apple and Cast devices.
+			s.Options = []string{"-tag:v", "hvc1"}
+		}
+		return
+	}
+
+	// Video Transcode.
+	if p.Container == "webm" {
+		// Constant quality mode.
+		// https://trac.ffmpeg.org/wiki/Encode/VP9
+		s.Codec = "vp9"
+		s.Encoder = "libvpx-vp9"
+		// A non-zero -b:v turns constant quality into constrained quality,
+		// capped at this bit rate.
+		s.Options = []string{
+			"-crf", "31", "-b:v", strconv.FormatInt(prof.MaxBitRate, 10),
+			"-deadline", "good", "-cpu-used", "2",
+			"-row-mt", "1",
+		}
+		s.Options = append(s.Options, prof.vbv()...)
+		if v.HDR != "" && prof.HDR {
+			// Profile 2 is 10 bits, required to keep the HDR signalling.
+			s.Options = append(s.Options, "-profile:v", "2", "-pix_fmt", "yuv420p10le")
+			s.Options = append(s.Options, hdrColors(v.HDR)...)
+		} else {
+			s.Options = append(s.Options, "-pix_fmt", "yuv420p")
+		}
+		return
+	}
+	if v.HDR != "" && prof.HDR {
+		// Keep the HDR signalling, which h264 in 8 bits can't carry.
+		// https://trac.ffmpeg.org/wiki/Encode/H.265
+		trc := hdrTransfer(v.HDR)
+		params := "hdr-opt=1:repeat-headers=1"
+		if v.HDR == "HLG" {
+			params = "repeat-headers=1"
+		}
+		s.Codec = "hevc"
+		s.Encoder = "libx265"
+		s.Options = []string{
+			"-preset", prof.preset(),
+			"-crf", "21",
+			"-pix_fmt", "yuv420p10le",
+			// Required for playback of h265 in mp4 on Apple and Cast devices.
+			"-tag:v", "hvc1",
+		}
+		s.Options = append(s.Options, prof.vbv()...)
+		s.Options = append(s.Options, hdrColors(v.HDR)...)
+		s.Options = append(s.Options, "-x265-params", params+":colorprim=bt2020:transfer="+trc+":colormatrix=bt2020nc")
+		return
+	}
+	// https://trac.ffmpeg.org/wiki/Encode/H.264
+	// https://trac.ffmpeg.org/wiki/HWAccelIntro; on nvidia, use h264_nvenc and h264_cuvid
+	// On Raspbian, use: h264_omx
+	s.Codec = "h264"
+	s.Encoder = "h264"
+	s.Options = []string{"-preset", prof.preset(), "-crf", "21"}
+	if l := prof.maxLevel("h264"); l != 0 {
+		s.Options = append(s.Options, "-level", fmt.Sprintf("%d.%d", l/10, l%10))
+	}
+	// Capped CRF: the quality is constant unless it would exceed the bit rate
+	// ceiling.
+	s.Options = append(s.Options, prof.vbv()...)
+	// Make sure we don't use yuv420p10le / High 10 or 4:2:2 / 4:4:4, which
+	// was the reason to transcode in the first place.
+	s.Options = append(s.Options, "-pix_fmt", "yuv420p")
+}
+
+// planAudio decides whether to copy or transcode the audio track.
+func (p *Plan) planAudio(prof *Profile, a *AudioTrack) StreamPlan {
+	s := StreamPlan{Index: a.Index, From: a.Codec, Lang: a.Lang, Title: a.Title}
+	if !prof.supportedAudio(a.Codec) {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%s is not supported", a.Codec))
+	} else if !canMux(p.Container, a.Codec) {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%s can't be stored in %s", a.Codec, p.Container))
+	} else if prof.MaxChannels != 0 && a.Channels > prof.MaxChannels {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%d channels is over %d", a.Channels, prof.MaxChannels))
+	} else if prof.MaxAudioBitRate != 0 && a.BitRate > prof.MaxAudioBitRate {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("bit rate %d is over %d", a.BitRate, prof.MaxAudioBitRate))
+	} else {
+		// Audio copy.
+		s.Copy = true
+		s.Codec = a.Codec
+		return s
+	}
+	if strings.Contains(strings.ToLower(a.Title), a.Codec) {
+		// e.g. "TrueHD Atmos 7.1" would be wrong once transcoded.
+		s.Title = ""
+	}
+	ch := a.Channels
+	if prof.MaxChannels != 0 && ch > prof.MaxChannels {
+		ch = prof.MaxChannels
+		if ch == 2 {
+			// Keep the surround and center channels audible, encoded as Dolby
+			// Pro Logic II, instead of the default downmix.
+			s.Filters = []string{"aresample=matrix_encoding=dplii"}
+		}
+		s.Options = []string{"-ac", strconv.Itoa(ch)}
+	}
+	if ch == 0 {
+		// Unknown layout; assume stereo.
+		ch = 2
+	}
+	if p.Container == "webm" {
+		// https://wiki.xiph.org/Opus_Recommended_Settings
+		s.Codec = "opus"
+		s.Encoder = "libopus"
+		s.Options = append(s.Options, "-b:a", prof.audioBitRate(128000))
+		if ch > 2 {
+			// libopus only accepts the Vorbis channel layouts for surround.
+			s.Options = append(s.Options, "-mapping_family", "1")
+			s.Filters = []string{"aformat=channel_layouts=7.1|5.1|stereo"}
+		}
+		return s
+	}
+	if ch > 2 && prof.supportedAudio("ac3") && canMux(p.Container, "ac3") {
+		// DTS, TrueHD and E-AC3 are converted to AC3 at the highest bit rate,
+		// which the device decodes or forwards to the TV or the receiver.
+		s.Codec = "ac3"
+		s.Encoder = "ac3"
+		if ch > 6 {
+			// AC3 is at most 5.1.
+			s.Options = []string{"-ac", "6"}
+		}
+		s.Options = append(s.Options, "-b:a", prof.audioBitRate(640000))
+		return s
+	}
+	// https://trac.ffmpeg.org/wiki/Encode/AAC
+	// The encoder keeps the channel layout; 64kbps per channel is transparent
+	// enough.
+	s.Codec = "aac"
+	s.Encoder = "aac"
+	s.Options = append(s.Options, "-b:a", prof.audioBitRate(64000*int64(ch)))
+	// TODO(maruel): Complained -vbr is unrecognized.
+	//s.Encoder, s.Options = "libfdk_aac", []string{"-vbr", "4"}
+	return s
+}
+
+// hdrTransfer returns the transfer characteristics of the HDR format hdr,
+// "HDR10" or "HLG".
+func hdrTransfer(hdr string) string {
+	if hdr == "HLG" {
+		return "arib-std-b67"
+	}
+	return "smpte2084"
+}
+
+// hdrColors returns the ffmpeg options to signal the HDR format hdr.
+func hdrColors(hdr string) []string {
+	return []string{"-color_primaries", "bt2020", "-color_trc", hdrTransfer(hdr), "-colorspace", "bt2020nc"}
+}
@@ -0,0 +1,528 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
+)
+
+func TestPlan(t *testing.T) {
+	data := []struct {
+		file  string
+		lang  string
+		d     Device
+		video string // "copy" or the output codec.
+		audio string // "copy", the output codec or "" for none.
+		// filters are the names of the video filters, comma separated.
+		filters string
+	}{
+		{"h264_aac.mkv", "eng", ChromeCast, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", ChromeCastUltra, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", ChromeOS, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", Browser, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", AndroidTV, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", DLNATV, "copy", "copy", ""},
+		{"h264_aac.mkv", "eng", WEBPWebPreview, "webp", "", "fps"},
+		{"hevc_hdr10.mkv", "eng", WEBPWebPreview, "webp", "", "tonemap,fps"},
+		{"mpeg2_interlaced.ts", "fre", WEBPWebPreview, "webp", "", "deinterlace,fps"},
+		{"hevc_hdr10.mkv", "eng", ChromeCast, "h264", "ac3", "scale,tonemap"},
+		{"hevc_hdr10.mkv", "eng", ChromeCastUltra, "copy", "ac3", ""},
+		{"hevc_hdr10.mkv", "eng", ChromeOS, "vp9", "opus", "scale,tonemap"},
+		{"hevc_hdr10.mkv", "eng", Browser, "vp9", "opus", "tonemap"},
+		{"hevc_hdr10.mkv", "eng", AndroidTV, "copy", "ac3", ""},
+		{"hevc_hdr10.mkv", "eng", DLNATV, "h264", "ac3", "scale,tonemap"},
+		{"hevc_hdr10.mkv", "fre", AndroidTV, "copy", "copy", ""},
+		{"mpeg2_interlaced.ts", "fre", ChromeCast, "h264", "copy", "deinterlace"},
+		{"mpeg2_interlaced.ts", "fre", ChromeOS, "vp9", "opus", "deinterlace"},
+		{"mpeg2_interlaced.ts", "fre", DLNATV, "h264", "aac", "deinterlace"},
+		{"mpeg2_interlaced.ts", "eng", ChromeCast, "h264", "copy", "deinterlace"},
+		{"mpeg2_interlaced.ts", "eng", ChromeOS, "vp9", "opus", "deinterlace"},
+		{"vp9_opus.webm", "eng", ChromeCast, "h264", "aac", ""},
+		{"vp9_opus.webm", "eng", ChromeCastUltra, "copy", "aac", ""},
+		{"vp9_opus.webm", "eng", ChromeOS, "copy", "copy", ""},
+		{"vp9_opus.webm", "eng", Browser, "vp9", "opus", ""},
+		{"vp9_opus.webm", "eng", AndroidTV, "copy", "copy", ""},
+		{"mpeg4_mp3.avi", "eng", ChromeCast, "h264", "copy", ""},
+		{"mpeg4_mp3.avi", "eng", ChromeOS, "vp9", "opus", ""},
+		{"mpeg4_mp3.avi", "eng", DLNATV, "h264", "copy", ""},
+		{"h264_high10.mkv", "eng", ChromeCast, "h264", "copy", ""},
+		{"h264_high10.mkv", "eng", AndroidTV, "h264", "copy", ""},
+		{"h264_high10.mkv", "eng", Browser, "vp9", "opus", ""},
+		{"h264_l51.mp4", "eng", ChromeCast, "h264", "copy", ""},
+		{"h264_l51.mp4", "eng", ChromeCastUltra, "h264", "copy", ""},
+		{"h264_l51.mp4", "eng", AndroidTV, "copy", "copy", ""},
+		{"h264_l51.mp4", "eng", Browser, "copy", "copy", ""},
+		{"h264_422.mov", "eng", ChromeCast, "h264", "copy", ""},
+		{"h264_422.mov", "eng", AndroidTV, "h264", "copy", ""},
+		{"vp9_444.webm", "eng", ChromeOS, "vp9", "opus", ""},
+		{"vp9_444.webm", "eng", AndroidTV, "h264", "copy", ""},
+	}
+	for i, l := range data {
+		v := identify(t, l.file, l.lang)
+		p, err := l.d.Plan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := summary(&p.Video); got != l.video {
+			t.Errorf("#%d: %s for %s: video %q, expected %q\n%s", i, l.file, l.d, got, l.video, p)
+		}
+		if got := filterNames(p.Video.Filters); got != l.filters {
+			t.Errorf("#%d: %s for %s: filters %q, expected %q\n%s", i, l.file, l.d, got, l.filters, p)
+		}
+		if len(p.Audios) > 1 {
+			t.Errorf("#%d: %s for %s: expected a single audio track\n%s", i, l.file, l.d, p)
+		}
+		var a *StreamPlan
+		if len(p.Audios) != 0 {
+			a = &p.Audios[0]
+		}
+		if got := summary(a); got != l.audio {
+			t.Errorf("#%d: %s for %s: audio %q, expected %q\n%s", i, l.file, l.d, got, l.audio, p)
+		}
+		if !p.Video.Copy && len(p.Video.Reasons) == 0 {
+			t.Errorf("#%d: %s for %s: expected a reason to transcode\n%s", i, l.file, l.d, p)
+		}
+		if a != nil && !a.Copy && len(a.Reasons) == 0 {
+			t.Errorf("#%d: %s for %s: expected a reason to transcode\n%s", i, l.file, l.d, p)
+		}
+	}
+}
+
+// filterNames returns the names of the filters, comma separated, e.g.
+// "deinterlace,scale".
+func filterNames(filters []string) string {
+	out := make([]string, 0, len(filters))
+	for _, f := range filters {
+		switch f {
+		case deinterlace:
+			out = append(out, "deinterlace")
+		case tonemapSDR:
+			out = append(out, "tonemap")
+		default:
+			out = append(out, strings.SplitN(f, "=", 2)[0])
+		}
+	}
+	return strings.Join(out, ",")
+}
+
+func TestPlan_webm(t *testing.T) {
+	data := []struct {
+		file      string
+		d         Device
+		container string
+		video     string
+		audio     string
+	}{
+		// The video is copied, so the preferred container is kept.
+		{"h264_aac.mkv", ChromeOS, "mp4", "copy", "copy"},
+		{"h264_aac.mkv", Browser, "mp4", "copy", "copy"},
+		{"vp9_opus.webm", ChromeOS, "mp4", "copy", "copy"},
+		// The video is re-encoded anyway.
+		{"mpeg4_mp3.avi", ChromeOS, "webm", "vp9", "opus"},
+		{"hevc_hdr10.mkv", Browser, "webm", "vp9", "opus"},
+		{"h264_high10.mkv", Browser, "webm", "vp9", "opus"},
+		// WebM isn't supported.
+		{"mpeg4_mp3.avi", ChromeCast, "mp4", "h264", "copy"},
+	}
+	for i, l := range data {
+		v := identify(t, l.file, "eng")
+		if c := l.d.Container(v); c != l.container {
+			t.Errorf("#%d: %s for %s: container %q, expected %q", i, l.file, l.d, c, l.container)
+		}
+		p, err := l.d.Plan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Container != l.container {
+			t.Errorf("#%d: %s for %s: unexpected plan %s", i, l.file, l.d, p.Container)
+		}
+		if l.container == "webm" && !reflect.DeepEqual(p.Muxer[:2], []string{"-cues_to_front", "1"}) {
+			t.Errorf("#%d: %s for %s: unexpected muxer %q", i, l.file, l.d, p.Muxer)
+		}
+		if got := summary(&p.Video); got != l.video {
+			t.Errorf("#%d: %s for %s: video %q, expected %q\n%s", i, l.file, l.d, got, l.video, p)
+		}
+		if got := summary(&p.Audios[0]); got != l.audio {
+			t.Errorf("#%d: %s for %s: audio %q, expected %q\n%s", i, l.file, l.d, got, l.audio, p)
+		}
+	}
+
+	// A profile can prefer WebM.
+	preferWebM(t, ChromeOS)
+	p, err := ChromeOS.Plan(identify(t, "h264_aac.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Container != "webm" || summary(&p.Video) != "vp9" || summary(&p.Audios[0]) != "opus" {
+		t.Fatalf("unexpected plan\n%s", p)
+	}
+}
+
+// preferWebM makes the device prefer WebM for the duration of the test.
+func preferWebM(t *testing.T, d Device) {
+	prof := *d.Profile()
+	prof.Containers = []string{"webm", "mp4"}
+	old := profiles[d]
+	profiles[d] = &prof
+	t.Cleanup(func() { profiles[d] = old })
+}
+
+func TestPlan_reasons(t *testing.T) {
+	p, err := ChromeCast.Plan(identify(t, "hevc_hdr10.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"3840x2160 is over 1920x1080", "HDR10 is tone mapped to SDR", "hevc is not supported"}
+	if !reflect.DeepEqual(p.Video.Reasons, want) {
+		t.Fatalf("unexpected reasons %q", p.Video.Reasons)
+	}
+	if !strings.HasPrefix(p.Video.Filters[0], "scale=w=1920:h=1080:") {
+		t.Fatalf("unexpected filters %q", p.Video.Filters)
+	}
+	if want := []string{"truehd is not supported"}; !reflect.DeepEqual(p.Audios[0].Reasons, want) {
+		t.Fatalf("unexpected reasons %q", p.Audios[0].Reasons)
+	}
+
+	p, err = ChromeCast.Plan(identify(t, "mpeg2_interlaced.ts", "fre"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{deinterlace}; !reflect.DeepEqual(p.Video.Filters, want) {
+		t.Fatalf("unexpected filters %q", p.Video.Filters)
+	}
+}
+
+func TestPlan_allAudio(t *testing.T) {
+	v := identify(t, "hevc_hdr10.mkv", "fre")
+	prof := *AndroidTV.Profile()
+	prof.AllAudio = true
+	old := profiles[AndroidTV]
+	profiles[AndroidTV] = &prof
+	t.Cleanup(func() { profiles[AndroidTV] = old })
+
+	p, err := AndroidTV.Plan(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The preferred French track first, then the others in container order.
+	var got []string
+	for _, a := range p.Audios {
+		got = append(got, fmt.Sprintf("%d %s %s %t", a.Index, a.Lang, summary(&a), a.Default))
+	}
+	want := []string{"3 fre copy true", "1 eng ac3 false", "2 eng copy false"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected audio tracks %q\n%s", got, p)
+	}
+	args := strings.Join(p.Args("in.mkv", "out.mp4"), " ")
+	for _, w := range []string{
+		"-map 0:0 -map 0:3 -map 0:1 -map 0:2 ",
+		"-c:a:0 copy -metadata:s:a:0 language=fre -metadata:s:a:0 title= -disposition:a:0 default ",
+		"-c:a:1 ac3 -ac:a:1 6 -b:a:1 640k -metadata:s:a:1 language=eng -metadata:s:a:1 title= -disposition:a:1 0 ",
+		"-c:a:2 copy -metadata:s:a:2 language=eng -metadata:s:a:2 title= -disposition:a:2 0 ",
+	} {
+		if !strings.Contains(args, w) {
+			t.Errorf("expected %q in %s", w, args)
+		}
+	}
+}
+
+func TestPlan_surround(t *testing.T) {
+	data := []struct {
+		d       Device
+		codec   string
+		options []string
+		filters []string
+	}{
+		// AC3 is passed through; AC3 is at most 5.1.
+		{ChromeCast, "ac3", []string{"-ac", "6", "-b:a", "640k"}, nil},
+		// AC3 is decoded.
+		{DLNATV, "ac3", []string{"-ac", "6", "-b:a", "640k"}, nil},
+		// Stereo only; the video is re-encoded so WebM is used.
+		{ChromeOS, "opus", []string{"-ac", "2", "-b:a", "128k"}, []string{"aresample=matrix_encoding=dplii"}},
+		{Browser, "opus", []string{"-ac", "2", "-b:a", "128k"}, []string{"aresample=matrix_encoding=dplii"}},
+	}
+	v := identify(t, "hevc_hdr10.mkv", "eng")
+	for _, l := range data {
+		p, err := l.d.Plan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := &p.Audios[0]
+		if a.Codec != l.codec || !reflect.DeepEqual(a.Options, l.options) || !reflect.DeepEqual(a.Filters, l.filters) {
+			t.Errorf("%s: unexpected audio %s %q %q", l.d, a.Codec, a.Options, a.Filters)
+		}
+	}
+
+	// A 5.1 AC3 track is downmixed for a stereo device even if it could be
+	// copied.
+	prof := *Browser.Profile()
+	prof.Containers = []string{"mp4"}
+	prof.Audio = append([]string{"ac3"}, prof.Audio...)
+	prof.AllAudio = true
+	old := profiles[Browser]
+	profiles[Browser] = &prof
+	t.Cleanup(func() { profiles[Browser] = old })
+	p, err := Browser.Plan(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &p.Audios[1]
+	if want := "#2 eng ac3 -> aac (aac) filters: aresample=matrix_encoding=dplii; 6 channels is over 2"; a.String() != want {
+		t.Fatalf("unexpected audio %q", a.String())
+	}
+	if want := []string{"-ac", "2", "-b:a", "128k"}; !reflect.DeepEqual(a.Options, want) {
+		t.Fatalf("unexpected options %q", a.Options)
+	}
+}
+
+func TestPlan_bitRate(t *testing.T) {
+	p, err := ChromeCast.Plan(identify(t, "hevc_hdr10.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(p.Video.Options, " "); !strings.Contains(got, "-crf 21 -level 4.1 -maxrate 12000000 -bufsize 24000000 ") {
+		t.Fatalf("unexpected options %q", got)
+	}
+
+	// The source is within the video ceiling but not the audio one.
+	prof := *ChromeCast.Profile()
+	prof.MaxAudioBitRate = 128000
+	old := profiles[ChromeCast]
+	profiles[ChromeCast] = &prof
+	t.Cleanup(func() { profiles[ChromeCast] = old })
+	p, err = ChromeCast.Plan(identify(t, "h264_aac.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Video.Copy {
+		t.Fatalf("expected video copy\n%s", p)
+	}
+	a := &p.Audios[0]
+	if want := "#1 eng aac -> aac (aac); bit rate 192000 is over 128000"; a.String() != want {
+		t.Fatalf("unexpected audio %q", a.String())
+	}
+	if want := []string{"-b:a", "128k"}; !reflect.DeepEqual(a.Options, want) {
+		t.Fatalf("unexpected options %q", a.Options)
+	}
+}
+
+func TestPlan_videoFormat(t *testing.T) {
+	data := []struct {
+		file     string
+		profile  string
+		level    int
+		bitDepth int
+		chroma   string
+		reason   string // For ChromeCast.
+	}{
+		{"h264_aac.mkv", "High", 40, 8, "4:2:0", ""},
+		{"h264_high10.mkv", "High 10", 41, 10, "4:2:0", "h264 profile \"High 10\" is not supported"},
+		{"h264_l51.mp4", "High", 51, 8, "4:2:0", "h264 level 51 is over 41"},
+		{"h264_422.mov", "High 4:2:2", 41, 8, "4:2:2", "4:2:2 chroma subsampling is not supported"},
+		{"hevc_hdr10.mkv", "Main 10", 153, 10, "4:2:0", "hevc is not supported"},
+		{"vp9_444.webm", "Profile 1", 0, 8, "4:4:4", "4:4:4 chroma subsampling is not supported"},
+	}
+	for _, l := range data {
+		v := identify(t, l.file, "eng")
+		if v.VideoProfile != l.profile || v.VideoLevel != l.level || v.BitDepth != l.bitDepth || v.Chroma != l.chroma {
+			t.Errorf("%s: %q level %d %d bits %s", l.file, v.VideoProfile, v.VideoLevel, v.BitDepth, v.Chroma)
+		}
+		if got := ChromeCast.Profile().rejectVideo(v); got != l.reason {
+			t.Errorf("%s: %q", l.file, got)
+		}
+		p, err := ChromeCast.Plan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Video.Copy && !strings.Contains(strings.Join(p.Video.Options, " "), "-pix_fmt yuv420p") {
+			t.Errorf("%s: expected 8 bits 4:2:0 output: %q", l.file, p.Video.Options)
+		}
+	}
+}
+
+func TestPlan_Args(t *testing.T) {
+	p, err := ChromeCast.Plan(identify(t, "h264_aac.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"-i", "in.mkv", "-f", "mp4",
+		"-movflags", "+faststart", "-map_metadata", "0", "-map_chapters", "0",
+		"-map", "0:0", "-map", "0:1",
+		"-c:v:0", "copy",
+		"-c:a:0", "copy", "-metadata:s:a:0", "language=eng",
+		"-metadata:s:a:0", "title=", "-disposition:a:0", "default",
+		"out.mp4",
+	}
+	if got := p.Args("in.mkv", "out.mp4"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected args\n%q\n%q", got, want)
+	}
+
+	p, err = ChromeCastUltra.Plan(identify(t, "hevc_hdr10.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(p.Args("in.mkv", "out.mp4"), " "); !strings.Contains(got, "-c:v:0 copy -tag:v:0 hvc1") {
+		t.Fatalf("expected hvc1 tag: %s", got)
+	}
+
+	p, err = WEBPWebPreview.Plan(identify(t, "h264_aac.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.Args("in.mkv", "out.webp"); got[len(got)-2] != "-an" {
+		t.Fatalf("expected no audio: %q", got)
+	}
+}
+
+func TestPlan_Verify(t *testing.T) {
+	v := identify(t, "hevc_hdr10.mkv", "eng")
+	b, err := os.ReadFile(filepath.Join("testdata", "hevc_hdr10.mkv.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The source itself as the output.
+	ffmpeg.Default = &ffmpegtest.Fake{Probes: map[string][]byte{"out.mp4": b}}
+	dst := filepath.Join(t.TempDir(), "out.mp4")
+	if err = os.WriteFile(dst, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := ChromeCast.Plan(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Verify(context.Background(), dst)
+	var verr *VerifyError
+	if !errors.As(err, &verr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	want := []string{"video is hevc, expected h264", "3 audio streams, expected 1"}
+	if !reflect.DeepEqual(verr.Reasons, want) {
+		t.Fatalf("unexpected reasons %q", verr.Reasons)
+	}
+
+	prof := *AndroidTV.Profile()
+	prof.AllAudio = true
+	old := profiles[AndroidTV]
+	profiles[AndroidTV] = &prof
+	t.Cleanup(func() { profiles[AndroidTV] = old })
+	if p, err = AndroidTV.Plan(v); err != nil {
+		t.Fatal(err)
+	}
+	err = p.Verify(context.Background(), dst)
+	if !errors.As(err, &verr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// The TrueHD track was copied instead of converted.
+	want = []string{"audio #0 is truehd, expected ac3"}
+	if !reflect.DeepEqual(verr.Reasons, want) {
+		t.Fatalf("unexpected reasons %q", verr.Reasons)
+	}
+}
+
+func TestPlan_Transcode(t *testing.T) {
+	p, err := ChromeCast.Plan(identify(t, "h264_aac.mkv", "eng"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(filepath.Join("testdata", "h264_aac.mkv.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &ffmpegtest.Fake{Probes: map[string][]byte{"in.mkv": b}}
+	ffmpeg.Default = f
+	d := t.TempDir()
+	src := filepath.Join(d, "in.mkv")
+	if err = os.WriteFile(src, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(d, "out", "in.mp4")
+	if err = p.Transcode(context.Background(), src, dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	// ffmpeg writes to a temporary file, which is renamed once complete.
+	if runs := f.Runs(); len(runs) != 1 || runs[0][len(runs[0])-1] != dst+PartialSuffix {
+		t.Fatalf("unexpected runs %q", runs)
+	}
+	if _, err = os.Stat(dst); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(dst + PartialSuffix); !os.IsNotExist(err) {
+		t.Fatalf("expected the partial file to be renamed: %v", err)
+	}
+
+	// The output is half as long as expected. It is kept aside.
+	p.Length *= 2
+	dst = filepath.Join(d, "out", "short.mp4")
+	err = p.Transcode(context.Background(), src, dst, nil)
+	var verr *VerifyError
+	if !errors.As(err, &verr) || len(verr.Reasons) != 1 || !strings.HasPrefix(verr.Reasons[0], "duration ") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err = os.Stat(dst + RejectedSuffix); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []string{dst, dst + PartialSuffix} {
+		if _, err = os.Stat(n); !os.IsNotExist(err) {
+			t.Fatalf("unexpected %s: %v", n, err)
+		}
+	}
+
+	// On failure, neither file is left behind.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dst = filepath.Join(d, "out", "canceled.mp4")
+	if err = p.Transcode(ctx, src, dst, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	for _, n := range []string{dst, dst + PartialSuffix} {
+		if _, err = os.Stat(n); !os.IsNotExist(err) {
+			t.Fatalf("unexpected %s: %v", n, err)
+		}
+	}
+}
+
+// identify runs Identify on a recorded probe from testdata/.
+func identify(t *testing.T, name, lang string) *Info {
+	b, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := ffmpeg.Default
+	ffmpeg.Default = &ffmpegtest.Fake{Probes: map[string][]byte{name: b}}
+	t.Cleanup(func() {
+		ffmpeg.Default = old
+	})
+	src := filepath.Join(t.TempDir(), name)
+	if err = os.WriteFile(src, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	v, err := Identify(context.Background(), src, []string{lang})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// summary returns "copy", the output codec or "" if s is nil.
+func summary(s *StreamPlan) string {
+	if s == nil {
+		return ""
+	}
+	if s.Copy {
+		return "copy"
+	}
+	return s.Codec
+}
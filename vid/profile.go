@@ -0,0 +1,416 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Profile describes what a device can play without transcoding.
+//
+// Codec, profile and level names are as reported by ffprobe. Zero values mean
+// no limit.
+type Profile struct {
+	Name       string       `json:"name"`       // Also the name of the cache directory.
+	Containers []string     `json:"containers"` // Output containers, preferred first, e.g. "mp4".
+	Video      []VideoCodec `json:"video"`
+	Audio      []string     `json:"audio"` // Decoded by the device, e.g. "aac".
+	// Passthrough are audio codecs forwarded as-is to the TV or the receiver,
+	// e.g. "ac3".
+	Passthrough []string `json:"passthrough"`
+	HDR         bool     `json:"hdr"` // Can display HDR10 and HLG.
+	// MaxWidth, MaxHeight and MaxFrameRate are enforced by scaling down and
+	// dropping frames, which forces a re-encode.
+	MaxWidth     int     `json:"max_width"`
+	MaxHeight    int     `json:"max_height"`
+	MaxFrameRate float64 `json:"max_frame_rate"`
+	// MaxBitRate and MaxAudioBitRate are in bits/s. A stream over the ceiling
+	// is transcoded, and encoders are constrained to stay below it.
+	MaxBitRate      int64 `json:"max_bit_rate"`
+	MaxAudioBitRate int64 `json:"max_audio_bit_rate"`
+	// MaxChannels is enforced by downmixing, e.g. 2 for stereo only.
+	MaxChannels int `json:"max_channels"`
+	// AllAudio maps every audio track instead of only the preferred one.
+	AllAudio bool `json:"all_audio"`
+	// Preset is the x264 and x265 preset, trading encoding speed for file
+	// size; defaults to "faster".
+	Preset string `json:"preset"`
+}
+
+// VideoCodec is a video codec supported by a device, with its limits.
+type VideoCodec struct {
+	Codec    string   `json:"codec"`    // e.g. "h264" or "hevc".
+	Profiles []string `json:"profiles"` // e.g. "High" or "Main 10".
+	// MaxLevel is as reported by ffprobe, e.g. 41 for h264 level 4.1 or 153
+	// for hevc level 5.1.
+	MaxLevel    int `json:"max_level"`
+	MaxBitDepth int `json:"max_bit_depth"`
+}
+
+// reject returns why the selected video stream doesn't fit within the
+// limits, or "" if it does.
+func (c *VideoCodec) reject(v *Info) string {
+	if len(c.Profiles) != 0 && !contains(c.Profiles, v.VideoProfile) {
+		return fmt.Sprintf("%s profile %q is not supported", c.Codec, v.VideoProfile)
+	}
+	if c.MaxLevel != 0 && v.VideoLevel > c.MaxLevel {
+		return fmt.Sprintf("%s level %d is over %d", c.Codec, v.VideoLevel, c.MaxLevel)
+	}
+	if c.MaxBitDepth != 0 && v.BitDepth > c.MaxBitDepth {
+		return fmt.Sprintf("%s in %d bits is over %d bits", c.Codec, v.BitDepth, c.MaxBitDepth)
+	}
+	return ""
+}
+
+// rejectVideo returns why the selected video stream can't be copied as-is
+// for this device, or "" if it can.
+func (p *Profile) rejectVideo(v *Info) string {
+	if v.Chroma != "" && v.Chroma != "4:2:0" {
+		// Hardware decoders only handle 4:2:0; this is not part of the profile
+		// name for every codec, e.g. vp9.
+		return fmt.Sprintf("%s chroma subsampling is not supported", v.Chroma)
+	}
+	reason := fmt.Sprintf("%s is not supported", v.VideoCodec)
+	for i := range p.Video {
+		if p.Video[i].Codec == v.VideoCodec {
+			if reason = p.Video[i].reject(v); reason == "" {
+				break
+			}
+		}
+	}
+	if reason != "" {
+		return reason
+	}
+	if b := videoBitRate(v); p.MaxBitRate != 0 && b > p.MaxBitRate {
+		return fmt.Sprintf("bit rate %d is over %d", b, p.MaxBitRate)
+	}
+	return ""
+}
+
+// videoBitRate returns the bit rate of the selected video stream.
+//
+// When the container doesn't tell, it is estimated from the overall bit rate
+// minus the audio streams, so a copy isn't blindly allowed.
+func videoBitRate(v *Info) int64 {
+	if v.VideoBitRate != 0 {
+		return v.VideoBitRate
+	}
+	b := v.BitRate
+	for i := range v.Audios {
+		b -= v.Audios[i].BitRate
+	}
+	if b < 0 {
+		return 0
+	}
+	return b
+}
+
+// audioBitRate returns the bit rate to encode audio at, capped to
+// MaxAudioBitRate.
+func (p *Profile) audioBitRate(want int64) string {
+	if p.MaxAudioBitRate != 0 && want > p.MaxAudioBitRate {
+		want = p.MaxAudioBitRate
+	}
+	return strconv.FormatInt(want/1000, 10) + "k"
+}
+
+// preset returns the x264 and x265 preset to use.
+func (p *Profile) preset() string {
+	if p.Preset != "" {
+		return p.Preset
+	}
+	// Transcode very fast. This creates large files but we don't care much
+	// here; the bit rate is limited by vbv().
+	return "faster"
+}
+
+// vbv returns the encoder options to keep the video bit rate below
+// MaxBitRate, or nil if there is no limit.
+//
+// The buffer is two seconds at the maximum rate, so short peaks are
+// smoothed without starving the device on a slow network.
+func (p *Profile) vbv() []string {
+	if p.MaxBitRate == 0 {
+		return nil
+	}
+	return []string{
+		"-maxrate", strconv.FormatInt(p.MaxBitRate, 10),
+		"-bufsize", strconv.FormatInt(2*p.MaxBitRate, 10),
+	}
+}
+
+// scaleFilter returns the filter to fit the video within MaxWidth and
+// MaxHeight, or "" if it already fits.
+//
+// The storage aspect ratio is kept, so the sample aspect ratio stays valid
+// and anamorphic videos are still displayed with the right shape.
+func (p *Profile) scaleFilter(v *Info) string {
+	w, h := p.MaxWidth, p.MaxHeight
+	if w == 0 || w > v.Width {
+		w = v.Width
+	}
+	if h == 0 || h > v.Height {
+		h = v.Height
+	}
+	if w == v.Width && h == v.Height {
+		return ""
+	}
+	// yuv420p requires even dimensions.
+	return fmt.Sprintf("scale=w=%d:h=%d:force_original_aspect_ratio=decrease:force_divisible_by=2", w, h)
+}
+
+// fpsFilter returns the filter to reduce the frame rate to MaxFrameRate, or
+// "" if it is already below.
+//
+// The frame rate is divided by an integer so the cadence is kept, e.g.
+// 59.94 becomes 29.97 instead of 30.
+func (p *Profile) fpsFilter(v *Info) string {
+	f := v.FrameRate.Float()
+	if p.MaxFrameRate == 0 || f <= p.MaxFrameRate {
+		return ""
+	}
+	n := 2
+	for f/float64(n) > p.MaxFrameRate {
+		n++
+	}
+	return fmt.Sprintf("fps=fps=%d/%d", v.FrameRate.Num, v.FrameRate.Den*n)
+}
+
+// maxLevel returns the maximum level supported for the codec, or 0 if there
+// is no limit.
+func (p *Profile) maxLevel(codec string) int {
+	for i := range p.Video {
+		if p.Video[i].Codec == codec {
+			return p.Video[i].MaxLevel
+		}
+	}
+	return 0
+}
+
+// supportedAudio returns true if this device supports this audio codec,
+// either natively or via passthrough.
+func (p *Profile) supportedAudio(codec string) bool {
+	return contains(p.Audio, codec) || contains(p.Passthrough, codec)
+}
+
+// h264Profiles are the 8 bits 4:2:0 h264 profiles.
+var h264Profiles = []string{"Constrained Baseline", "Baseline", "Main", "High"}
+
+// profiles are the known device profiles, indexed by Device.
+//
+// https://developers.google.com/cast/docs/media
+var profiles = map[Device]*Profile{
+	ChromeCast: {
+		Name:       "ChromeCast",
+		Containers: []string{"mp4"},
+		Video: []VideoCodec{
+			{Codec: "h264", Profiles: h264Profiles, MaxLevel: 41, MaxBitDepth: 8},
+			{Codec: "vp8"},
+			{Codec: "mpeg1video"},
+			{Codec: "mpeg2video"},
+		},
+		// TODO(maruel): Confirm they all work.
+		Audio: []string{"aac", "mp2", "mp3"},
+		// All TVs can decode it.
+		Passthrough:  []string{"ac3"},
+		MaxWidth:     1920,
+		MaxHeight:    1080,
+		MaxFrameRate: 30,
+		// Higher bit rates buffer over Wi-Fi.
+		MaxBitRate: 12000000,
+	},
+	ChromeCastUltra: {
+		Name:       "ChromeCastUltra",
+		Containers: []string{"mp4"},
+		Video: []VideoCodec{
+			{Codec: "h264", Profiles: h264Profiles, MaxLevel: 42, MaxBitDepth: 8},
+			{Codec: "hevc", Profiles: []string{"Main", "Main 10"}, MaxLevel: 153, MaxBitDepth: 10},
+			{Codec: "vp9", MaxBitDepth: 10},
+			{Codec: "vp8"},
+			{Codec: "mpeg1video"},
+			{Codec: "mpeg2video"},
+		},
+		Audio:        []string{"aac", "mp2", "mp3"},
+		Passthrough:  []string{"ac3"},
+		HDR:          true,
+		MaxWidth:     3840,
+		MaxHeight:    2160,
+		MaxFrameRate: 60,
+	},
+	ChromeOS: {
+		// The files are meant to be stored on a device. WebM is used when the
+		// video is re-encoded; see Device.Container.
+		//
+		// https://support.google.com/chromebook/answer/183093
+		Name:       "ChromeOS",
+		Containers: []string{"mp4", "webm"},
+		Video: []VideoCodec{
+			{Codec: "h264", MaxBitDepth: 8},
+			{Codec: "vp9", MaxBitDepth: 8},
+			{Codec: "vp8"},
+			{Codec: "mpeg1video"},
+			{Codec: "mpeg2video"},
+		},
+		// No AC3 at all.
+		Audio:       []string{"aac", "mp2", "mp3", "opus", "vorbis"},
+		MaxWidth:    1920,
+		MaxHeight:   1080,
+		MaxChannels: 2,
+		// The files are meant to be stored on a device. Keep them small.
+		Preset: "slow",
+	},
+	WEBPWebPreview: {
+		// The video is always transcoded and the audio is dropped.
+		Name:       "WEBPWebPreview",
+		Containers: []string{"webp"},
+	},
+	Browser: {
+		// What Chrome, Edge, Firefox and Safari all play in mp4; Safari's webm
+		// support is partial, so mp4 is preferred.
+		Name:       "Browser",
+		Containers: []string{"mp4", "webm"},
+		Video: []VideoCodec{
+			{Codec: "h264", Profiles: h264Profiles, MaxLevel: 51, MaxBitDepth: 8},
+		},
+		Audio: []string{"aac", "mp3"},
+		// Desktop speakers and headphones are stereo; downmix to Dolby Pro Logic
+		// II instead of relying on the browser's downmix.
+		MaxChannels: 2,
+	},
+	AndroidTV: {
+		Name:       "AndroidTV",
+		Containers: []string{"mp4"},
+		Video: []VideoCodec{
+			{Codec: "h264", Profiles: h264Profiles, MaxLevel: 51, MaxBitDepth: 8},
+			{Codec: "hevc", Profiles: []string{"Main", "Main 10"}, MaxLevel: 153, MaxBitDepth: 10},
+			{Codec: "vp9", MaxBitDepth: 10},
+			{Codec: "av1", Profiles: []string{"Main"}, MaxBitDepth: 10},
+			{Codec: "vp8"},
+			{Codec: "mpeg2video"},
+		},
+		Audio:        []string{"aac", "mp2", "mp3", "opus"},
+		Passthrough:  []string{"ac3", "eac3"},
+		HDR:          true,
+		MaxWidth:     3840,
+		MaxHeight:    2160,
+		MaxFrameRate: 60,
+	},
+	DLNATV: {
+		Name:       "DLNATV",
+		Containers: []string{"mp4"},
+		Video: []VideoCodec{
+			{Codec: "h264", Profiles: h264Profiles, MaxLevel: 41, MaxBitDepth: 8},
+			{Codec: "mpeg2video"},
+		},
+		Audio:           []string{"aac", "ac3", "mp3"},
+		MaxWidth:        1920,
+		MaxHeight:       1080,
+		MaxFrameRate:    30,
+		MaxBitRate:      20000000,
+		MaxAudioBitRate: 640000,
+	},
+}
+
+// Profile returns the capabilities of the device, or nil if the device is
+// unknown.
+func (d Device) Profile() *Profile {
+	return profiles[d]
+}
+
+func (d Device) String() string {
+	if p := profiles[d]; p != nil {
+		return p.Name
+	}
+	return "Device(" + strconv.Itoa(int(d)) + ")"
+}
+
+// DeviceByName returns the device with this name, ignoring case.
+func DeviceByName(name string) (Device, bool) {
+	for d, p := range profiles {
+		if strings.EqualFold(p.Name, name) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Devices returns all the known devices, including the ones added by
+// LoadProfiles.
+func Devices() []Device {
+	out := make([]Device, 0, len(profiles))
+	for d := range profiles {
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// LoadProfiles loads a JSON file containing a list of Profile.
+//
+// A profile with the name of a known device, ignoring case, replaces its
+// profile, otherwise a new Device is added. It must be called at startup, before the
+// devices are used.
+func LoadProfiles(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("LoadProfiles(%s): %v", path, err)
+	}
+	var l []*Profile
+	if err := json.Unmarshal(b, &l); err != nil {
+		return fmt.Errorf("LoadProfiles(%s): %v", path, err)
+	}
+	for _, p := range l {
+		if p.Name == "" {
+			return fmt.Errorf("LoadProfiles(%s): profile without a name", path)
+		}
+		if len(p.Containers) == 0 {
+			return fmt.Errorf("LoadProfiles(%s): %s: no container", path, p.Name)
+		}
+	}
+	for _, p := range l {
+		d, ok := DeviceByName(p.Name)
+		if !ok {
+			for k := range profiles {
+				if k > d {
+					d = k
+				}
+			}
+			d++
+		}
+		profiles[d] = p
+	}
+	return nil
+}
+
+// webmCodecs are the codecs that can be stored in a webm file.
+var webmCodecs = []string{"av1", "opus", "vorbis", "vp8", "vp9"}
+
+// canMux returns true if a stream with this codec can be copied in the
+// container.
+func canMux(container, codec string) bool {
+	switch container {
+	case "webm":
+		return contains(webmCodecs, codec)
+	case "mp4":
+		// The mp4 muxer rejects these.
+		return codec != "vp8" && codec != "vorbis"
+	default:
+		return true
+	}
+}
+
+func contains(l []string, s string) bool {
+	for _, i := range l {
+		if i == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+)
+
+func TestProfile_rejectVideo(t *testing.T) {
+	data := []struct {
+		d    Device
+		v    Info
+		want bool
+	}{
+		{ChromeCast, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 41, BitDepth: 8, Width: 1920, Height: 1080}, true},
+		{ChromeCast, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 51, BitDepth: 8, Width: 1920, Height: 1080}, false},
+		{ChromeCast, Info{VideoCodec: "h264", VideoProfile: "High 10", VideoLevel: 41, BitDepth: 10, Width: 1920, Height: 1080}, false},
+		// The resolution is handled by scaleFilter.
+		{ChromeCast, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 41, BitDepth: 8, Width: 3840, Height: 2160}, true},
+		{ChromeCast, Info{VideoCodec: "hevc", VideoProfile: "Main", VideoLevel: 120, BitDepth: 8, Width: 1920, Height: 1080}, false},
+		{ChromeCastUltra, Info{VideoCodec: "hevc", VideoProfile: "Main 10", VideoLevel: 153, BitDepth: 10, Width: 3840, Height: 2160}, true},
+		{ChromeOS, Info{VideoCodec: "mpeg4", BitDepth: 8}, false},
+		{WEBPWebPreview, Info{VideoCodec: "h264", BitDepth: 8}, false},
+		{DLNATV, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 41, BitDepth: 8, VideoBitRate: 25000000}, false},
+		// The video bit rate is estimated from the overall bit rate.
+		{DLNATV, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 41, BitDepth: 8, BitRate: 25000000}, false},
+		{DLNATV, Info{VideoCodec: "h264", VideoProfile: "High", VideoLevel: 41, BitDepth: 8, BitRate: 25000000, Audios: []AudioTrack{{BitRate: 6000000}}}, true},
+	}
+	for i, l := range data {
+		if got := l.d.Profile().rejectVideo(&l.v); (got == "") != l.want {
+			t.Errorf("#%d: %s: %s = %q", i, l.d, l.v.VideoCodec, got)
+		}
+	}
+}
+
+func TestProfile_supportedAudio(t *testing.T) {
+	if !ChromeCast.Profile().supportedAudio("ac3") {
+		t.Fatal("ChromeCast does passthrough")
+	}
+	if ChromeOS.Profile().supportedAudio("ac3") {
+		t.Fatal("ChromeOS doesn't support ac3")
+	}
+	if ChromeCast.Profile().supportedAudio("dts") {
+		t.Fatal("ChromeCast doesn't support dts")
+	}
+}
+
+func TestProfile_scaleFilter(t *testing.T) {
+	data := []struct {
+		p    Profile
+		w, h int
+		want string
+	}{
+		{Profile{MaxWidth: 1920, MaxHeight: 1080}, 1920, 1080, ""},
+		{Profile{MaxWidth: 1920, MaxHeight: 1080}, 1920, 800, ""},
+		{Profile{}, 3840, 2160, ""},
+		{Profile{MaxWidth: 1920, MaxHeight: 1080}, 3840, 2160, "scale=w=1920:h=1080:force_original_aspect_ratio=decrease:force_divisible_by=2"},
+		{Profile{MaxWidth: 1280}, 1920, 1080, "scale=w=1280:h=1080:force_original_aspect_ratio=decrease:force_divisible_by=2"},
+		{Profile{MaxHeight: 576}, 1440, 1080, "scale=w=1440:h=576:force_original_aspect_ratio=decrease:force_divisible_by=2"},
+	}
+	for i, l := range data {
+		if got := l.p.scaleFilter(&Info{Width: l.w, Height: l.h}); got != l.want {
+			t.Errorf("#%d: %q", i, got)
+		}
+	}
+}
+
+func TestProfile_fpsFilter(t *testing.T) {
+	data := []struct {
+		max  float64
+		fr   Rational
+		want string
+	}{
+		{30, Rational{30000, 1001}, ""},
+		{30, Rational{30, 1}, ""},
+		{0, Rational{120, 1}, ""},
+		{30, Rational{60000, 1001}, "fps=fps=60000/2002"},
+		{30, Rational{50, 1}, "fps=fps=50/2"},
+		{30, Rational{120, 1}, "fps=fps=120/4"},
+		{24, Rational{30, 1}, "fps=fps=30/2"},
+	}
+	for i, l := range data {
+		p := Profile{MaxFrameRate: l.max}
+		if got := p.fpsFilter(&Info{FrameRate: l.fr}); got != l.want {
+			t.Errorf("#%d: %q", i, got)
+		}
+	}
+}
+
+func TestCanMux(t *testing.T) {
+	data := []struct {
+		container, codec string
+		want             bool
+	}{
+		{"webm", "vp9", true},
+		{"webm", "opus", true},
+		{"webm", "h264", false},
+		{"webm", "aac", false},
+		{"mp4", "h264", true},
+		{"mp4", "vp8", false},
+	}
+	for _, l := range data {
+		if got := canMux(l.container, l.codec); got != l.want {
+			t.Errorf("canMux(%q, %q) = %t", l.container, l.codec, got)
+		}
+	}
+	// So h264 sources are copied.
+	if ChromeOS.ToContainer() != "mp4" {
+		t.Fatal("ChromeOS prefers mp4")
+	}
+}
+
+func TestBitDepth(t *testing.T) {
+	data := map[string]int{
+		"yuv420p":     8,
+		"yuvj420p":    8,
+		"nv12":        8,
+		"yuv420p10le": 10,
+		"yuv422p10be": 10,
+		"p010le":      10,
+		"yuv444p12le": 12,
+	}
+	for pixFmt, want := range data {
+		if got := bitDepth(pixFmt); got != want {
+			t.Errorf("bitDepth(%q) = %d; want %d", pixFmt, got, want)
+		}
+	}
+}
+
+func TestVideoBitDepth(t *testing.T) {
+	data := []struct {
+		s    ffmpeg.Stream
+		want int
+	}{
+		{ffmpeg.Stream{PixFmt: "yuv420p", BitsPerRawSample: "8"}, 8},
+		{ffmpeg.Stream{PixFmt: "yuv420p10le"}, 10},
+		{ffmpeg.Stream{BitsPerRawSample: "10"}, 10},
+		{ffmpeg.Stream{PixFmt: "yuv420p", BitsPerRawSample: "10"}, 10},
+		{ffmpeg.Stream{}, 8},
+	}
+	for i, l := range data {
+		if got := videoBitDepth(&l.s); got != l.want {
+			t.Errorf("#%d: %d; want %d", i, got, l.want)
+		}
+	}
+}
+
+func TestChroma(t *testing.T) {
+	data := map[string]string{
+		"yuv420p":     "4:2:0",
+		"yuvj420p":    "4:2:0",
+		"nv12":        "4:2:0",
+		"p010le":      "4:2:0",
+		"yuv420p10le": "4:2:0",
+		"yuv422p":     "4:2:2",
+		"yuv422p10le": "4:2:2",
+		"yuv444p":     "4:4:4",
+		"gbrp10le":    "4:4:4",
+		"gray":        "4:0:0",
+		"":            "",
+	}
+	for pixFmt, want := range data {
+		if got := chroma(pixFmt); got != want {
+			t.Errorf("chroma(%q) = %q; want %q", pixFmt, got, want)
+		}
+	}
+}
+
+func TestNormalizeLevel(t *testing.T) {
+	data := []struct {
+		codec       string
+		level, want int
+	}{
+		{"h264", 41, 41},
+		{"h264", 9, 11},
+		{"hevc", 153, 153},
+		{"vp9", -99, 0},
+	}
+	for _, l := range data {
+		if got := normalizeLevel(l.codec, l.level); got != l.want {
+			t.Errorf("normalizeLevel(%q, %d) = %d; want %d", l.codec, l.level, got, l.want)
+		}
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	old := make(map[Device]*Profile, len(profiles))
+	for k, v := range profiles {
+		old[k] = v
+	}
+	t.Cleanup(func() { profiles = old })
+
+	p := filepath.Join(t.TempDir(), "profiles.json")
+	b := []byte(`[
+  {"name": "ChromeOS", "containers": ["mp4"], "video": [{"codec": "hevc"}], "audio": ["aac", "ac3"]},
+  {"name": "Kitchen", "containers": ["mp4"], "video": [{"codec": "h264", "max_level": 31}], "audio": ["aac"], "max_width": 1280, "preset": "veryslow"}
+]`)
+	if err := os.WriteFile(p, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadProfiles(p); err != nil {
+		t.Fatal(err)
+	}
+	if !ChromeOS.Profile().supportedAudio("ac3") {
+		t.Fatal("expected override")
+	}
+	devs := Devices()
+	if len(devs) != len(old)+1 {
+		t.Fatalf("expected a new device: %v", devs)
+	}
+	d := devs[len(devs)-1]
+	if s := d.String(); s != "Kitchen" {
+		t.Fatalf("unexpected name %q", s)
+	}
+	if d.Profile().rejectVideo(&Info{VideoCodec: "h264", VideoLevel: 40, Width: 1280}) == "" {
+		t.Fatal("level is too high")
+	}
+	if d.ToContainer() != "mp4" {
+		t.Fatal("unexpected container")
+	}
+	// The encoder preset comes from the profile, including for an overridden
+	// built-in device.
+	v := identify(t, "mpeg4_mp3.avi", "eng")
+	for dev, want := range map[Device]string{d: "veryslow", ChromeOS: "faster"} {
+		plan, err := dev.Plan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o := plan.Video.Options; len(o) < 2 || o[0] != "-preset" || o[1] != want {
+			t.Fatalf("%s: unexpected options %q", dev, o)
+		}
+	}
+
+	if err := os.WriteFile(p, []byte(`[{"name": "NoContainer"}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if LoadProfiles(p) == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+)
+
+// Thumbnail sizes, in pixels.
+const (
+	posterWidth = 480
+	spriteWidth = 160
+	// spriteColumns is the number of thumbnails per row in a sprite sheet.
+	spriteColumns = 10
+	// spriteMax is the maximum number of thumbnails in a sprite sheet; the
+	// interval is increased for long videos.
+	spriteMax = 100
+)
+
+// spriteInterval is the minimum duration between two thumbnails in a sprite
+// sheet.
+const spriteInterval = 10 * time.Second
+
+// posterFilter only keeps frames which are mostly not black, e.g. fades, then
+// selects the most representative frame of each batch of 100 frames.
+//
+// https://ffmpeg.org/ffmpeg-filters.html#blackframe
+// https://ffmpeg.org/ffmpeg-filters.html#thumbnail
+const posterFilter = "blackframe=amount=0,metadata=mode=select:key=lavfi.blackframe.pblack:value=50:function=less,thumbnail=n=100"
+
+// Poster extracts a representative frame of the video as a JPEG file.
+//
+// The intro is skipped by seeking at 10% of the duration, and black frames
+// are skipped.
+//
+// The src file must have been analyzed via Identify() first.
+func Poster(ctx context.Context, src, dst string, v *Info) error {
+	if err := mkdirFor(dst); err != nil {
+		return fmt.Errorf("Poster(%s, %s): %v", src, dst, err)
+	}
+	var args []string
+	if v.Length > 0 {
+		args = append(args, "-ss", formatSeconds(v.Length/10))
+	}
+	filters := append(thumbnailFilters(v), posterFilter, thumbnailScale(v, posterWidth))
+	args = append(args,
+		"-i", src,
+		"-map", fmt.Sprintf("0:%d", v.VideoIndex),
+		"-filter:v", strings.Join(filters, ","),
+		"-frames:v", "1",
+		"-an", "-sn")
+	log.Printf("Poster(%s) running: ffmpeg %s", src, strings.Join(args, " "))
+	if out, err := writeJPEG(ctx, args, dst); err != nil {
+		log.Printf("Poster(%s) = %v\n%s", src, err, out)
+		return fmt.Errorf("Poster(%s, %s): %v", src, dst, err)
+	}
+	return nil
+}
+
+// Sprite builds a sprite sheet of thumbnails for seek previews as a JPEG file
+// and the WebVTT thumbnail track vtt referencing it.
+//
+// Only key frames are decoded, so it is much faster than decoding the whole
+// video. The cues reference dst by its base name, so both files must be
+// served from the same directory.
+//
+// The src file must have been analyzed via Identify() first.
+func Sprite(ctx context.Context, src, dst, vtt string, v *Info) error {
+	if v.Length <= 0 || v.Width == 0 || v.Height == 0 {
+		return fmt.Errorf("Sprite(%s, %s): unknown duration or size", src, dst)
+	}
+	if err := mkdirFor(dst); err != nil {
+		return fmt.Errorf("Sprite(%s, %s): %v", src, dst, err)
+	}
+	interval, n := spriteLayout(v.Length)
+	w, h := spriteWidth, thumbnailHeight(v, spriteWidth)
+	filters := append(thumbnailFilters(v),
+		fmt.Sprintf("fps=fps=1/%s", formatSeconds(interval)),
+		thumbnailScale(v, w),
+		fmt.Sprintf("tile=%dx%d", spriteColumns, (n+spriteColumns-1)/spriteColumns))
+	args := []string{
+		"-skip_frame", "nokey",
+		"-i", src,
+		"-map", fmt.Sprintf("0:%d", v.VideoIndex),
+		"-filter:v", strings.Join(filters, ","),
+		"-frames:v", "1",
+		"-an", "-sn",
+	}
+	log.Printf("Sprite(%s) running: ffmpeg %s", src, strings.Join(args, " "))
+	if out, err := writeJPEG(ctx, args, dst); err != nil {
+		log.Printf("Sprite(%s) = %v\n%s", src, err, out)
+		return fmt.Errorf("Sprite(%s, %s): %v", src, dst, err)
+	}
+	// The track is written last, so its presence means the sprite is complete.
+	tmp := vtt + PartialSuffix
+	err := os.WriteFile(tmp, []byte(spriteVTT(filepath.Base(dst), v.Length, interval, w, h)), 0o644)
+	if err == nil {
+		err = os.Rename(tmp, vtt)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		os.Remove(dst)
+		return fmt.Errorf("Sprite(%s, %s): %v", src, dst, err)
+	}
+	return nil
+}
+
+// writeJPEG runs ffmpeg with args to write a single JPEG image to dst.
+//
+// The image is written to dst+PartialSuffix first and renamed once complete,
+// so an interrupted run never leaves a truncated image at dst.
+func writeJPEG(ctx context.Context, args []string, dst string) ([]byte, error) {
+	tmp := dst + PartialSuffix
+	// The extension doesn't tell the format anymore.
+	args = append(args, "-c:v", "mjpeg", "-f", "image2", "-update", "1", "-y", tmp)
+	out, err := ffmpeg.Transcode(ctx, args, nil)
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return out, err
+}
+
+// spriteLayout returns the interval between two thumbnails and the number of
+// thumbnails to cover a video of length l.
+func spriteLayout(l time.Duration) (time.Duration, int) {
+	interval := spriteInterval
+	if l/spriteMax > interval {
+		// Whole seconds, rounded up.
+		interval = (l/spriteMax + time.Second).Truncate(time.Second)
+	}
+	return interval, int((l + interval - 1) / interval)
+}
+
+// spriteVTT returns the WebVTT thumbnail track for a sprite sheet, one cue
+// per thumbnail using media fragments.
+//
+// https://www.w3.org/TR/media-frags/#naming-space
+func spriteVTT(sprite string, l, interval time.Duration, w, h int) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for i := 0; time.Duration(i)*interval < l; i++ {
+		start := time.Duration(i) * interval
+		end := start + interval
+		if end > l {
+			end = l
+		}
+		fmt.Fprintf(&b, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n", vttTime(start), vttTime(end), sprite, (i%spriteColumns)*w, (i/spriteColumns)*h, w, h)
+	}
+	return b.String()
+}
+
+// thumbnailHeight returns the height of a thumbnail w pixels wide, keeping
+// the display aspect ratio of the frames. It is even, as required by
+// yuv420p.
+//
+// Anamorphic videos, e.g. DVDs, have non-square pixels, so the display aspect
+// ratio differs from the one of the frames.
+func thumbnailHeight(v *Info, w int) int {
+	sar := v.SampleAspect
+	if sar.Num <= 0 || sar.Den <= 0 {
+		sar = Rational{Num: 1, Den: 1}
+	}
+	h := w * v.Height * sar.Den / (v.Width * sar.Num)
+	return h + h%2
+}
+
+// thumbnailScale returns the filter to scale the frames to thumbnails w
+// pixels wide with square pixels.
+func thumbnailScale(v *Info, w int) string {
+	if v.Width == 0 || v.Height == 0 {
+		return fmt.Sprintf("scale=w=%d:h=-2", w)
+	}
+	return fmt.Sprintf("scale=w=%d:h=%d,setsar=1", w, thumbnailHeight(v, w))
+}
+
+// thumbnailFilters returns the filters so the frames look right once
+// extracted.
+func thumbnailFilters(v *Info) []string {
+	var out []string
+	if v.Interlaced {
+		out = append(out, deinterlace)
+	}
+	if v.HDR != "" {
+		out = append(out, tonemapSDR)
+	}
+	return out
+}
+
+// vttTime formats a WebVTT timestamp, e.g. "00:01:30.000".
+func vttTime(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// formatSeconds formats a duration in seconds as accepted by ffmpeg.
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
+// mkdirFor creates the directory of the file p if needed.
+func mkdirFor(p string) error {
+	dir := filepath.Dir(p)
+	if i, err := os.Stat(dir); err == nil && i.IsDir() {
+		return nil
+	}
+	return os.MkdirAll(dir, 0o777)
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
+)
+
+func TestSpriteLayout(t *testing.T) {
+	data := []struct {
+		l        time.Duration
+		interval time.Duration
+		n        int
+	}{
+		{time.Second, 10 * time.Second, 1},
+		{95 * time.Second, 10 * time.Second, 10},
+		{1000 * time.Second, 10 * time.Second, 100},
+		{2 * time.Hour, 73 * time.Second, 99},
+	}
+	for i, l := range data {
+		if interval, n := spriteLayout(l.l); interval != l.interval || n != l.n {
+			t.Errorf("#%d: spriteLayout(%s) = %s, %d", i, l.l, interval, n)
+		}
+	}
+}
+
+func TestSpriteVTT(t *testing.T) {
+	got := spriteVTT("a.jpg", 115*time.Second, 10*time.Second, 160, 90)
+	for _, want := range []string{
+		"WEBVTT\n\n00:00:00.000 --> 00:00:10.000\na.jpg#xywh=0,0,160,90\n",
+		"\n00:01:30.000 --> 00:01:40.000\na.jpg#xywh=1440,0,160,90\n",
+		"\n00:01:40.000 --> 00:01:50.000\na.jpg#xywh=0,90,160,90\n",
+		"\n00:01:50.000 --> 00:01:55.000\na.jpg#xywh=160,90,160,90\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, " --> "); n != 12 {
+		t.Errorf("expected 12 cues, got %d", n)
+	}
+}
+
+func TestThumbnailHeight(t *testing.T) {
+	data := []struct {
+		file string
+		w    int
+		want int
+	}{
+		{"h264_aac.mkv", 160, 90},
+		{"hevc_hdr10.mkv", 480, 270},
+		{"mpeg4_mp3.avi", 160, 88},
+		// 720x576 with non-square pixels is displayed as 16:9.
+		{"mpeg2_interlaced.ts", 160, 90},
+		{"mpeg2_interlaced.ts", 480, 270},
+	}
+	for i, l := range data {
+		v := identify(t, l.file, "")
+		if got := thumbnailHeight(v, l.w); got != l.want {
+			t.Errorf("#%d: thumbnailHeight(%s, %d) = %d, want %d", i, l.file, l.w, got, l.want)
+		}
+	}
+}
+
+func TestThumbnails(t *testing.T) {
+	v := identify(t, "hevc_hdr10.mkv", "eng")
+	f := &ffmpegtest.Fake{}
+	ffmpeg.Default = f
+	d := t.TempDir()
+	src := filepath.Join(d, "in.mkv")
+	if err := os.WriteFile(src, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(d, "a", "in.jpg")
+	if err := Poster(context.Background(), src, dst, v); err != nil {
+		t.Fatal(err)
+	}
+	sprite := filepath.Join(d, "a", "in.sprite.jpg")
+	vtt := filepath.Join(d, "a", "in.sprite.vtt")
+	if err := Sprite(context.Background(), src, sprite, vtt, v); err != nil {
+		t.Fatal(err)
+	}
+	runs := f.Runs()
+	if len(runs) != 2 {
+		t.Fatalf("expected 2 runs, got %q", runs)
+	}
+	poster := strings.Join(runs[0], " ")
+	if !strings.Contains(poster, "-ss ") || !strings.Contains(poster, tonemapSDR+","+posterFilter+",scale=w=480:h=270,setsar=1 ") {
+		t.Errorf("unexpected poster args %s", poster)
+	}
+	if s := strings.Join(runs[1], " "); !strings.HasPrefix(s, "-hide_banner -skip_frame nokey -i ") || !strings.Contains(s, ",scale=w=160:h=90,setsar=1,tile=10x") {
+		t.Errorf("unexpected sprite args %s", s)
+	}
+	// The images are written to a temporary file first.
+	for i, r := range runs {
+		if s := strings.Join(r, " "); !strings.HasSuffix(s, " -f image2 -update 1 -y "+[]string{dst, sprite}[i]+PartialSuffix) {
+			t.Errorf("#%d: unexpected output %s", i, s)
+		}
+	}
+	for _, p := range []string{dst, sprite, vtt} {
+		if _, err := os.Stat(p); err != nil {
+			t.Error(err)
+		}
+		if _, err := os.Stat(p + PartialSuffix); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be renamed: %v", p, err)
+		}
+	}
+	b, err := os.ReadFile(vtt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "in.sprite.jpg#xywh=0,0,160,90\n") {
+		t.Errorf("unexpected WebVTT:\n%s", b)
+	}
+}
@@ -5,14 +5,13 @@
 // Package vid identifies and transcodes video files via ffprobe and ffmpeg.
 package vid
 
-//go:generate go install golang.org/x/tools/cmd/stringer@latest
-//go:generate stringer --type Device
-
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,30 +20,276 @@ import (
 
 // Info contains the analyzed information about a video.
 type Info struct {
-	Container  string // Copy of .Raw.Format.FormatName
-	Duration   string // Rounded user readable duration.
-	VideoIndex int
-	VideoCodec string
-	AudioIndex int
-	AudioCodec string
-	AudioLang  string
-	Raw        ffmpeg.ProbeResult
+	Container string        // Copy of .Raw.Format.FormatName
+	Duration  string        // Rounded user readable duration.
+	Length    time.Duration // Exact duration.
+	Size      int64         // File size in bytes.
+	BitRate   int64         // Overall bit rate in bits/s.
+
+	// Selected video stream; see Videos for all of them.
+	VideoIndex   int
+	VideoCodec   string
+	VideoBitRate int64  // In bits/s; 0 if unknown.
+	VideoProfile string // e.g. "High" or "Main 10".
+	VideoLevel   int    // As reported by ffprobe, e.g. 41 for h264 4.1; 0 if unknown.
+	PixFmt       string // e.g. "yuv420p".
+	BitDepth     int
+	Chroma       string // Chroma subsampling, e.g. "4:2:0".
+	Width        int
+	Height       int
+	FrameRate    Rational
+	SampleAspect Rational // Sample aspect ratio; zero if unknown.
+	NbFrames     int      // 0 if unknown.
+	HDR          string   // "HDR10" or "HLG" if the selected video stream is HDR.
+	Interlaced   bool     // The selected video stream is interlaced.
+
+	// Selected audio stream; see Audios for all of them.
+	AudioIndex         int
+	AudioCodec         string
+	AudioLang          string
+	AudioBitRate       int64 // In bits/s; 0 if unknown.
+	AudioChannels      int
+	AudioChannelLayout string // e.g. "stereo" or "5.1(side)".
+
+	Videos    []VideoTrack    // All video streams except cover art, in container order.
+	Audios    []AudioTrack    // All usable audio streams, in container order.
+	Subtitles []SubtitleTrack // All subtitle streams, in container order.
+	Chapters  []Chapter
+	Raw       ffmpeg.ProbeResult
+}
+
+// Chapter is a chapter marker in the video.
+type Chapter struct {
+	Start time.Duration
+	End   time.Duration
+	Title string
+}
+
+// Rational is a rational number as output by ffprobe, e.g. "30000/1001".
+type Rational struct {
+	Num int
+	Den int
+}
+
+// Float returns the value of the rational number, or 0 if it is invalid.
+func (r Rational) Float() float64 {
+	if r.Den == 0 {
+		return 0
+	}
+	return float64(r.Num) / float64(r.Den)
+}
+
+func (r Rational) String() string {
+	if r.Den == 1 {
+		return strconv.Itoa(r.Num)
+	}
+	return fmt.Sprintf("%d/%d", r.Num, r.Den)
+}
+
+// parseRational parses a "num/den" string. Returns the zero value on failure.
+func parseRational(s string) Rational {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return Rational{}
+	}
+	n, err1 := strconv.Atoi(parts[0])
+	d, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || d == 0 {
+		return Rational{}
+	}
+	return Rational{Num: n, Den: d}
+}
+
+// parseInt parses an integer as output by ffprobe. Returns 0 on failure,
+// which is what ffprobe reports as "N/A".
+func parseInt(s string) int64 {
+	i, _ := strconv.ParseInt(s, 10, 64)
+	return i
+}
+
+// streamBitRate returns the bit rate of a stream.
+//
+// Matroska doesn't store it in the stream header but mkvmerge adds a tag.
+func streamBitRate(s *ffmpeg.Stream) int64 {
+	if b := parseInt(s.BitRate); b != 0 {
+		return b
+	}
+	return parseInt(s.Tags["BPS"])
+}
+
+// VideoTrack describes one video stream in the container.
+type VideoTrack struct {
+	Index        int // Stream index in the container.
+	Codec        string
+	BitRate      int64  // In bits/s; 0 if unknown.
+	Profile      string // e.g. "High" or "Main 10".
+	Level        int    // As reported by ffprobe, e.g. 41 for h264 4.1; 0 if unknown.
+	PixFmt       string // e.g. "yuv420p".
+	BitDepth     int
+	Chroma       string // Chroma subsampling, e.g. "4:2:0".
+	Width        int
+	Height       int
+	FrameRate    Rational
+	SampleAspect Rational       // Sample aspect ratio; zero if unknown.
+	NbFrames     int            // 0 if unknown.
+	HDR          string         // "HDR10", "HLG" or "" for SDR.
+	Interlaced   bool           // Based on the field order.
+	Disposition  map[string]int // Copy of ffprobe's disposition flags.
+}
+
+// bitDepth returns the number of bits per component of a pixel format, e.g.
+// 10 for "yuv420p10le".
+func bitDepth(pixFmt string) int {
+	pixFmt = strings.TrimSuffix(strings.TrimSuffix(pixFmt, "le"), "be")
+	i := len(pixFmt)
+	for i > 0 && pixFmt[i-1] >= '0' && pixFmt[i-1] <= '9' {
+		i--
+	}
+	// Formats like "yuv420p" or "nv12" have no depth suffix.
+	if i == len(pixFmt) || pixFmt[i-1] != 'p' {
+		return 8
+	}
+	d, _ := strconv.Atoi(pixFmt[i:])
+	return d
+}
+
+// videoBitDepth returns the number of bits per component of a video stream.
+//
+// The pixel format is the decoder's output and is empty when ffprobe has no
+// decoder for the codec, while bits_per_raw_sample is the coded depth but is
+// not reported by every demuxer. The largest of the two wins.
+func videoBitDepth(s *ffmpeg.Stream) int {
+	d := 0
+	if s.PixFmt != "" {
+		d = bitDepth(s.PixFmt)
+	}
+	if b := int(parseInt(s.BitsPerRawSample)); b > d {
+		d = b
+	}
+	if d == 0 {
+		return 8
+	}
+	return d
+}
+
+// chroma returns the chroma subsampling of a pixel format, e.g. "4:2:0" for
+// "yuv420p10le", or "" if unknown.
+func chroma(pixFmt string) string {
+	switch {
+	case strings.HasPrefix(pixFmt, "yuv420"), strings.HasPrefix(pixFmt, "yuvj420"),
+		strings.HasPrefix(pixFmt, "yuva420"), pixFmt == "nv12", pixFmt == "nv21",
+		strings.HasPrefix(pixFmt, "p010"), strings.HasPrefix(pixFmt, "p016"):
+		return "4:2:0"
+	case strings.HasPrefix(pixFmt, "yuv422"), strings.HasPrefix(pixFmt, "yuvj422"),
+		strings.HasPrefix(pixFmt, "yuva422"), strings.HasPrefix(pixFmt, "nv16"),
+		strings.HasPrefix(pixFmt, "p210"), pixFmt == "yuyv422", pixFmt == "uyvy422":
+		return "4:2:2"
+	case strings.HasPrefix(pixFmt, "yuv444"), strings.HasPrefix(pixFmt, "yuvj444"),
+		strings.HasPrefix(pixFmt, "yuva444"), strings.HasPrefix(pixFmt, "gbr"),
+		strings.HasPrefix(pixFmt, "nv24"), strings.HasPrefix(pixFmt, "p410"):
+		return "4:4:4"
+	case strings.HasPrefix(pixFmt, "gray"):
+		return "4:0:0"
+	default:
+		return ""
+	}
+}
+
+// normalizeLevel returns the level as a number that can be compared with
+// VideoCodec.MaxLevel, or 0 if unknown.
+func normalizeLevel(codec string, level int) int {
+	if level < 0 {
+		// ffprobe reports -99 (FF_LEVEL_UNKNOWN), e.g. for vp9.
+		return 0
+	}
+	if codec == "h264" && level == 9 {
+		// Level 1b is reported as 9 for the High profiles; it is between 1 and
+		// 1.1.
+		return 11
+	}
+	return level
+}
+
+// isInterlaced returns true if the field order denotes interlaced content.
+//
+// "unknown" and "" are considered progressive; use DetectInterlacing() to
+// analyze the frames in this case.
+func isInterlaced(fieldOrder string) bool {
+	switch fieldOrder {
+	case "tt", "bb", "tb", "bt":
+		return true
+	default:
+		return false
+	}
+}
+
+// hdrFormat returns the HDR format of a video stream based on its transfer
+// characteristics, or "" if it is SDR.
+func hdrFormat(s *ffmpeg.Stream) string {
+	switch s.ColorTransfer {
+	case "smpte2084":
+		// Perceptual quantizer.
+		return "HDR10"
+	case "arib-std-b67":
+		return "HLG"
+	default:
+		return ""
+	}
+}
+
+// AudioTrack describes one audio stream in the container.
+type AudioTrack struct {
+	Index         int // Stream index in the container.
+	Codec         string
+	Lang          string // "und" when not specified.
+	Title         string // e.g. "Director's commentary".
+	BitRate       int64  // In bits/s; 0 if unknown.
+	Channels      int
+	ChannelLayout string         // e.g. "stereo" or "5.1(side)".
+	Disposition   map[string]int // Copy of ffprobe's disposition flags.
+}
+
+// SubtitleTrack describes one subtitle stream in the container.
+type SubtitleTrack struct {
+	Index       int // Stream index in the container.
+	Codec       string
+	Lang        string // "und" when not specified.
+	Title       string
+	Disposition map[string]int // Copy of ffprobe's disposition flags.
+}
+
+// IsText returns true if the subtitle is text based and can be converted to
+// WebVTT.
+//
+// Bitmap subtitles like dvd_subtitle and hdmv_pgs_subtitle would need OCR.
+func (s *SubtitleTrack) IsText() bool {
+	switch s.Codec {
+	case "ass", "mov_text", "ssa", "subrip", "text", "webvtt":
+		return true
+	default:
+		return false
+	}
 }
 
 // Identify runs ffprobe on a file and analyzes its output.
 //
-// lang shall be the preferred language, e.g. "eng" or "fre".
-func Identify(src string, lang string) (*Info, error) {
+// langs shall be the preferred languages in order of preference, e.g.
+// []string{"fre", "eng"}. When no audio track matches any of the languages,
+// the track flagged as default is used, otherwise the first one.
+func Identify(ctx context.Context, src string, langs []string) (*Info, error) {
 	out := &Info{}
-	if err := ffmpeg.Probe(src, &out.Raw); err != nil {
+	if err := ffmpeg.Probe(ctx, src, &out.Raw); err != nil {
 		return nil, err
 	}
 	out.Container = out.Raw.Format.FormatName
+	out.Size = parseInt(out.Raw.Format.Size)
+	out.BitRate = parseInt(out.Raw.Format.BitRate)
 	if out.Raw.Format.Duration != "" {
 		d, err := time.ParseDuration(out.Raw.Format.Duration + "s")
 		if err != nil {
 			return nil, err
 		}
+		out.Length = d
 		// Round with only two units.
 		if d > time.Hour {
 			out.Duration = d.Round(time.Minute).String()
@@ -56,46 +301,282 @@ func Identify(src string, lang string) (*Info, error) {
 		out.Duration = strings.Replace(out.Duration, "m0s", "m", 1)
 		out.Duration = strings.Replace(out.Duration, "h0m", "h", 1)
 	}
-	var audios, videos []int
-	for i, s := range out.Raw.Streams {
+	for _, s := range out.Raw.Streams {
 		switch s.CodecType {
 		case "video":
-			if s.Tags["mimetype"] == "image/jpeg" {
-				// Likely a cover.jpeg.
+			if s.Disposition["attached_pic"] != 0 || strings.HasPrefix(s.Tags["mimetype"], "image/") {
+				// Likely a cover.jpeg or a thumbnail.
 				continue
 			}
-			videos = append(videos, i)
+			fr := parseRational(s.AvgFrameRate)
+			if fr.Num == 0 {
+				fr = parseRational(s.RFrameRate)
+			}
+			nb := parseInt(s.NbFrames)
+			if nb == 0 {
+				nb = parseInt(s.Tags["NUMBER_OF_FRAMES"])
+			}
+			out.Videos = append(out.Videos, VideoTrack{
+				Index:        s.Index,
+				Codec:        s.CodecName,
+				BitRate:      streamBitRate(&s),
+				Profile:      s.Profile,
+				Level:        normalizeLevel(s.CodecName, s.Level),
+				PixFmt:       s.PixFmt,
+				BitDepth:     videoBitDepth(&s),
+				Chroma:       chroma(s.PixFmt),
+				Width:        s.Width,
+				Height:       s.Height,
+				FrameRate:    fr,
+				SampleAspect: parseRational(strings.Replace(s.SampleAspectRatio, ":", "/", 1)),
+				NbFrames:     int(nb),
+				HDR:          hdrFormat(&s),
+				Interlaced:   isInterlaced(s.FieldOrder),
+				Disposition:  s.Disposition,
+			})
 		case "audio":
 			if s.CodecName != "" {
 				// Do not add audio tracks without a codec. It seems to happen.
-				audios = append(audios, i)
+				lang := s.Tags["language"]
+				if lang == "" {
+					lang = "und"
+				}
+				out.Audios = append(out.Audios, AudioTrack{
+					Index:         s.Index,
+					Codec:         s.CodecName,
+					Lang:          lang,
+					Title:         s.Tags["title"],
+					BitRate:       streamBitRate(&s),
+					Channels:      s.Channels,
+					ChannelLayout: s.ChannelLayout,
+					Disposition:   s.Disposition,
+				})
 			}
-		case "data", "subtitle":
+		case "subtitle":
+			lang := s.Tags["language"]
+			if lang == "" {
+				lang = "und"
+			}
+			out.Subtitles = append(out.Subtitles, SubtitleTrack{
+				Index:       s.Index,
+				Codec:       s.CodecName,
+				Lang:        lang,
+				Title:       s.Tags["title"],
+				Disposition: s.Disposition,
+			})
+		case "data", "attachment":
+			// e.g. timecodes, or the fonts of ASS subtitles in Matroska.
 		default:
 			return nil, fmt.Errorf("Identify(%s): unknown stream %q", src, s.CodecType)
 		}
 	}
-	// Choose the preferred stream based on preferences.
-	if len(videos) > 1 {
-		return nil, fmt.Errorf("Identify(%s): too many video streams", src)
+	for _, c := range out.Raw.Chapters {
+		start, err1 := time.ParseDuration(c.StartTime + "s")
+		end, err2 := time.ParseDuration(c.EndTime + "s")
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("Identify(%s): invalid chapter %d", src, c.ID)
+		}
+		out.Chapters = append(out.Chapters, Chapter{Start: start, End: end, Title: c.Tags["title"]})
 	}
-	if len(videos) == 0 {
+	// Choose the preferred stream based on preferences.
+	if len(out.Videos) == 0 {
 		return nil, fmt.Errorf("Identify(%s): no video stream found", src)
 	}
-	out.VideoIndex = out.Raw.Streams[videos[0]].Index
-	out.VideoCodec = out.Raw.Streams[videos[0]].CodecName
-	for _, i := range audios {
-		if out.AudioLang == lang {
+	out.setVideo(chooseVideo(out.Videos))
+	if a := chooseAudio(out.Audios, langs); a != nil {
+		out.setAudio(a)
+	}
+	return out, nil
+}
+
+// setVideo copies the properties of the selected video stream.
+func (i *Info) setVideo(v *VideoTrack) {
+	i.VideoIndex = v.Index
+	i.VideoCodec = v.Codec
+	i.VideoBitRate = v.BitRate
+	i.VideoProfile = v.Profile
+	i.VideoLevel = v.Level
+	i.PixFmt = v.PixFmt
+	i.BitDepth = v.BitDepth
+	i.Chroma = v.Chroma
+	i.Width = v.Width
+	i.Height = v.Height
+	i.FrameRate = v.FrameRate
+	i.SampleAspect = v.SampleAspect
+	i.NbFrames = v.NbFrames
+	i.HDR = v.HDR
+	i.Interlaced = v.Interlaced
+}
+
+// setAudio copies the properties of the selected audio stream.
+func (i *Info) setAudio(a *AudioTrack) {
+	i.AudioIndex = a.Index
+	i.AudioCodec = a.Codec
+	i.AudioLang = a.Lang
+	i.AudioBitRate = a.BitRate
+	i.AudioChannels = a.Channels
+	i.AudioChannelLayout = a.ChannelLayout
+}
+
+// SelectVideo overrides the video stream chosen by Identify.
+//
+// index is the stream index in the container, as in VideoTrack.Index.
+func (i *Info) SelectVideo(index int) error {
+	for j := range i.Videos {
+		if i.Videos[j].Index == index {
+			i.setVideo(&i.Videos[j])
+			return nil
+		}
+	}
+	return fmt.Errorf("SelectVideo(%d): no such video stream", index)
+}
+
+// DetectInterlacing analyzes the first frames of the selected video stream
+// with ffmpeg's idet filter when ffprobe didn't report the field order.
+//
+// It is slower than Identify() so it is optional. It updates i.Interlaced.
+func (i *Info) DetectInterlacing(ctx context.Context, src string) error {
+	for _, s := range i.Raw.Streams {
+		if s.Index != i.VideoIndex {
+			continue
+		}
+		if s.FieldOrder != "" && s.FieldOrder != "unknown" {
+			return nil
+		}
+		tff, bff, progressive, err := ffmpeg.Idet(ctx, src, i.VideoIndex, 500)
+		if err != nil {
+			return err
+		}
+		i.Interlaced = tff+bff > progressive
+		for j := range i.Videos {
+			if i.Videos[j].Index == i.VideoIndex {
+				i.Videos[j].Interlaced = i.Interlaced
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("DetectInterlacing(%s): no video stream %d", src, i.VideoIndex)
+}
+
+// chooseVideo returns the main video track.
+//
+// The track flagged as default is preferred, then the one with the highest
+// resolution. tracks must not be empty.
+func chooseVideo(tracks []VideoTrack) *VideoTrack {
+	best := &tracks[0]
+	for i := range tracks[1:] {
+		v := &tracks[i+1]
+		bd, vd := best.Disposition["default"] != 0, v.Disposition["default"] != 0
+		if vd && !bd || vd == bd && v.Width*v.Height > best.Width*best.Height {
+			best = v
+		}
+	}
+	return best
+}
+
+// chooseAudio returns the preferred audio track.
+//
+// For each language in order, the default track is preferred, then any track
+// that is not a commentary or an audio description. Returns nil if there is
+// no audio track.
+func chooseAudio(tracks []AudioTrack, langs []string) *AudioTrack {
+	for _, l := range langs {
+		var found *AudioTrack
+		for i := range tracks {
+			a := &tracks[i]
+			if a.Lang != l {
+				continue
+			}
+			if a.Disposition["default"] != 0 {
+				return a
+			}
+			if found == nil && a.Disposition["comment"] == 0 && a.Disposition["visual_impaired"] == 0 {
+				found = a
+			}
+		}
+		if found != nil {
+			return found
+		}
+	}
+	for i := range tracks {
+		if tracks[i].Disposition["default"] != 0 {
+			return &tracks[i]
+		}
+	}
+	if len(tracks) != 0 {
+		return &tracks[0]
+	}
+	return nil
+}
+
+// SplitList splits a comma separated list as given on the command line, e.g.
+// the preferred languages "fre, eng". Spaces around the items are trimmed and
+// empty items are dropped.
+func SplitList(s string) []string {
+	var out []string
+	for _, i := range strings.Split(s, ",") {
+		if i = strings.TrimSpace(i); i != "" {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// ExtractSubtitles extracts all the text subtitles in src as WebVTT files.
+//
+// Each file is named dstBase + ".<index>.<lang>.vtt", where index is the
+// stream index in src. Returns the paths of the files written.
+//
+// The src file must have been analyzed via Identify() first.
+func ExtractSubtitles(ctx context.Context, src, dstBase string, v *Info) ([]string, error) {
+	args := []string{"-i", src}
+	var out []string
+	for i := range v.Subtitles {
+		t := &v.Subtitles[i]
+		if !t.IsText() {
 			continue
 		}
-		out.AudioIndex = out.Raw.Streams[i].Index
-		out.AudioCodec = out.Raw.Streams[i].CodecName
-		out.AudioLang = out.Raw.Streams[i].Tags["language"]
+		dst := fmt.Sprintf("%s.%d.%s.vtt", dstBase, t.Index, t.Lang)
+		args = append(args, "-map", fmt.Sprintf("0:%d", t.Index), "-c:s", "webvtt", "-f", "webvtt", "-y", dst)
+		out = append(out, dst)
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	log.Printf("ExtractSubtitles(%s) running: ffmpeg %s", src, strings.Join(args, " "))
+	if o, err := ffmpeg.Transcode(ctx, args, nil); err != nil {
+		log.Printf("ExtractSubtitles(%s) = %v\n%s", src, err, o)
+		for _, dst := range out {
+			os.Remove(dst)
+		}
+		return nil, fmt.Errorf("ExtractSubtitles(%s): %v", src, err)
 	}
 	return out, nil
 }
 
+// ConvertSubtitle converts a subtitle file, e.g. a .srt or .ass file, to
+// WebVTT.
+func ConvertSubtitle(ctx context.Context, src, dst string) error {
+	dir := filepath.Dir(dst)
+	if i, err := os.Stat(dir); err != nil || !i.IsDir() {
+		if err := os.MkdirAll(dir, 0o777); err != nil {
+			return fmt.Errorf("ConvertSubtitle(%s, %s): %v", src, dst, err)
+		}
+	}
+	args := []string{"-i", src, "-c:s", "webvtt", "-f", "webvtt", "-y", dst}
+	log.Printf("ConvertSubtitle(%s) running: ffmpeg %s", src, strings.Join(args, " "))
+	if out, err := ffmpeg.Transcode(ctx, args, nil); err != nil {
+		log.Printf("ConvertSubtitle(%s) = %v\n%s", src, err, out)
+		os.Remove(dst)
+		return fmt.Errorf("ConvertSubtitle(%s, %s): %v", src, dst, err)
+	}
+	return nil
+}
+
 // Device is a type of device to target.
+//
+// Its capabilities are described by its Profile. The built-in ones can be
+// overridden and new ones added via LoadProfiles.
 type Device int
 
 const (
@@ -139,153 +620,134 @@ const (
 
 	// WEBPWebPreview generates a web preview of the video in WEBP
 	WEBPWebPreview
+
+	// Browser is a desktop web browser playing HTML5 video.
+	//
+	// https://developer.mozilla.org/docs/Web/Media/Formats/Video_codecs
+	Browser
+
+	// AndroidTV is an Android TV or Google TV device, including the ChromeCast
+	// with Google TV. It decodes HEVC and AV1 and forwards E-AC3.
+	//
+	// https://developer.android.com/media/platform/supported-formats
+	AndroidTV
+
+	// DLNATV is a conservative profile for smart TVs playing over DLNA or from
+	// a USB stick; 1080p h264 with AAC or AC3.
+	DLNATV
 )
 
-// supportedVideo returns true if this device supports this video codec.
-func (d Device) supportedVideo(codec string) bool {
-	// WEBPWebPreview always returns false for video since it's going to be transcoded.
-	if d == WEBPWebPreview {
-		return false
-	}
-	switch codec {
-	case "mpeg1video", "mpeg2video", "h264":
-		return true
-	case "vp8":
-		// TODO(maruel): Depends on the container.
-		return true
-	case "h265":
-		return d == ChromeCastUltra
-	default:
-		// mpeg4, msmpeg4v3, svq3, wmv1
-		return false
+// deinterlace is the filter to deinterlace, outputting one frame per frame.
+//
+// https://ffmpeg.org/ffmpeg-filters.html#bwdif
+const deinterlace = "bwdif=mode=send_frame:parity=auto:deint=all"
+
+// tonemapSDR is the filter chain to convert HDR to SDR BT.709.
+//
+// It requires ffmpeg to be built with --enable-libzimg.
+//
+// https://ffmpeg.org/ffmpeg-filters.html#tonemap-1
+const tonemapSDR = "zscale=t=linear:npl=100,format=gbrpf32le,zscale=p=bt709,tonemap=tonemap=hable:desat=0,zscale=t=bt709:m=bt709:r=tv,format=yuv420p"
+
+// ToContainer returns the preferred output container of the device.
+func (d Device) ToContainer() string {
+	if p := d.Profile(); p != nil && len(p.Containers) != 0 {
+		return p.Containers[0]
 	}
+	return "mp4"
 }
 
-// supportedAudio returns true if this device supports this audio codec.
-func (d Device) supportedAudio(codec string) bool {
-	// WEBPWebPreview always returns true for audio since it's ignored.
-	if d == WEBPWebPreview {
-		return true
-	}
-	switch codec {
-	case "ac3":
-		// ChromeOS doesn't support this, Cast does passthrough, which is fine
-		// since all TVs can decode it.
-		return d != ChromeOS
-	// TODO(maruel): Confirm they all work.
-	case "aac", "mp2", "mp3":
-		return true
-	default:
-		// pcm_u8, wmav2
-		// Seems like ChromeCast doesn't support "dts".
-		return false
+// Containers returns the output containers of the device, the preferred one
+// first.
+func (d Device) Containers() []string {
+	if p := d.Profile(); p != nil && len(p.Containers) != 0 {
+		return p.Containers
 	}
+	return []string{"mp4"}
 }
 
-func (d Device) ToContainer() string {
-	if d == WEBPWebPreview {
-		return "webp"
+// Container returns the output container of the device for the video.
+//
+// It is the preferred one, unless the video has to be re-encoded anyway and
+// the device also plays WebM, whose VP9 and Opus encoders compress better.
+// The video is copied when possible, so it is never re-encoded only to change
+// the container.
+func (d Device) Container(v *Info) string {
+	c := d.ToContainer()
+	prof := d.Profile()
+	if prof == nil || c == "webm" || !contains(prof.Containers, "webm") {
+		return c
 	}
-	// TODO(maruel): Implement in the case of ChromeOS.
-	return "mp4"
+	p := &Plan{Device: d, Container: c}
+	p.planVideo(prof, v)
+	if p.Video.Copy {
+		return c
+	}
+	return "webm"
 }
 
-// Transcode transcodes a video file for playback on the device as MP4.
+// Transcode transcodes a video file for playback on the device in the
+// container returned by Container.
 //
-// The generated file is a mp4 file with 'faststart' for fast seeking.
+// A mp4 file is generated with 'faststart' and a webm file with its cues at
+// the front, both for fast seeking.
 //
 // The src file must have been analyzed via Identify() first.
 //
 // progress will be updated with progress information.
-func (d Device) Transcode(src, dst string, v *Info, progress func(frame int)) error {
-	c := d.ToContainer()
-	args := []string{
-		"-i", src,
-		"-f", c,
-	}
-	if c == "mp4" {
-		// https://trac.ffmpeg.org/wiki/Encode/AAC#ProgressiveDownload
-		args = append(args, "-movflags", "+faststart")
-		// TODO(maruel): Confirm.
-		args = append(args, "-map", fmt.Sprintf("0:%d", v.VideoIndex))
-		args = append(args, "-map", fmt.Sprintf("0:%d", v.AudioIndex))
-	}
-
-	if d == WEBPWebPreview {
-		args = append(args,
-			"-itsoffset", "1:00",
-			"-itsscale", "2",
-			"-t", "30",
-			"-vcodec", "libwebp",
-			"-filter:v", "fps=fps=2",
-			"-lossless", "0", "-compression_level", "3",
-			"-loop", "1",
-			"-s", "320:-1")
-		// "-preset", "default",
-		// "-vsync", "0",
-	} else if d.supportedVideo(v.VideoCodec) {
-		// Video Copy.
-		args = append(args, "-c:v", "copy")
-	} else {
-		// Video Transcode.
-		// https://trac.ffmpeg.org/wiki/Encode/H.264
-		// https://trac.ffmpeg.org/wiki/Encode/H.265; only works with ChromeCast Ultra.
-		// https://trac.ffmpeg.org/wiki/HWAccelIntro; on nvidia, use h264_nvenc and h264_cuvid
-		// On Raspbian, use: h264_omx
-		args = append(args, "-c:v", "h264")
-		switch d {
-		case ChromeCast, ChromeCastUltra:
-			// Transcode very fast. This creates large files but we don't care much
-			// here. We want to limit the bitrate.
-			args = append(args,
-				"-preset", "faster",
-				"-crf", "21",
-				"-level", "4.1",
-				// Make sure we don't use yuv420p10le / High 10.
-				"-pix_fmt", "yuv420p",
-				//"-x264opts", "vbv-bufsize=50000:vbv-maxrate=50000:nal-hrd=vb",
-				//"-b:v", "8M",
-				//"-maxrate", "8M",
-				//"-bufsize", "21M",
-			)
-		case ChromeOS:
-			// The file is meant to be stored on a device. Keep it small.
-			args = append(args, "-preset", "slow", "-crf", "21")
-		}
+//
+// When ctx is canceled, ffmpeg is killed and the partial output is deleted.
+func (d Device) Transcode(ctx context.Context, src, dst string, v *Info, progress func(p ffmpeg.Progress)) error {
+	plan, err := d.Plan(v)
+	if err != nil {
+		return fmt.Errorf("Transcode(%s, %s): %v", src, dst, err)
 	}
+	return plan.Transcode(ctx, src, dst, progress)
+}
 
-	if d == WEBPWebPreview {
-		// No audio.
-		args = append(args, "-an")
-	} else if d.supportedAudio(v.AudioCodec) {
-		// Audio copy.
-		args = append(args, "-c:a", "copy")
-	} else {
-		// Audio Transcode.
-		// https://trac.ffmpeg.org/wiki/Encode/AAC
-		args = append(args, "-c:a", "aac")
-		// TODO(maruel): Complained -vbr is unrecognized.
-		//args = append(args, "-c:a", "libfdk_aac", "-vbr", "4")
-	}
+// PartialSuffix is appended to the path of the file being transcoded. It is
+// renamed to its final name once complete, so a file with this suffix was
+// left by an interrupted transcoding, e.g. a crash or a power cut.
+const PartialSuffix = ".partial"
 
-	switch v.AudioLang {
-	case "", "und":
-	default:
-		// TODO(maruel): Doesn't seem to work.
-		args = append(args, "-metadata:s:a:0", fmt.Sprintf("language=%s", v.AudioLang))
-	}
+// RejectedSuffix is appended to the path of a transcoded file that failed
+// Plan.Verify. It is kept aside for inspection instead of being served.
+const RejectedSuffix = ".rejected"
 
-	args = append(args, dst)
-	dir := filepath.Dir(dst)
-	if i, err := os.Stat(dir); err != nil || !i.IsDir() {
-		if err := os.MkdirAll(dir, 0o777); err != nil {
-			return fmt.Errorf("Transcode(%s, %s): %v", src, dst, err)
-		}
+// Transcode executes the plan.
+//
+// The output is written to dst + PartialSuffix, checked with Verify, then
+// renamed to dst on success, so dst is never a truncated file. If the
+// verification fails, it is renamed to dst + RejectedSuffix instead and a
+// wrapped *VerifyError is returned.
+//
+// When ctx is canceled, ffmpeg is killed and the partial output is deleted.
+func (p *Plan) Transcode(ctx context.Context, src, dst string, progress func(p ffmpeg.Progress)) error {
+	if err := mkdirFor(dst); err != nil {
+		return fmt.Errorf("Transcode(%s, %s): %v", src, dst, err)
 	}
+	return p.write(ctx, src, dst, p.Args(src, dst+PartialSuffix), progress)
+}
+
+// write runs ffmpeg with args, which writes dst + PartialSuffix, then verifies
+// the output and renames it to dst.
+func (p *Plan) write(ctx context.Context, src, dst string, args []string, progress func(p ffmpeg.Progress)) error {
+	tmp := dst + PartialSuffix
 	log.Printf("Transcode(%s) running: ffmpeg %s", src, strings.Join(args, " "))
-	if out, err := ffmpeg.Transcode(args, progress); err != nil {
+	if out, err := ffmpeg.Transcode(ctx, args, progress); err != nil {
 		log.Printf("Transcode(%s) = %v\n%s", src, err, out)
-		os.Remove(dst)
+		os.Remove(tmp)
+		return fmt.Errorf("Transcode(%s, %s): %v", src, dst, err)
+	}
+	if err := p.Verify(ctx, tmp); err != nil {
+		log.Printf("Transcode(%s) = %v", src, err)
+		if err2 := os.Rename(tmp, dst+RejectedSuffix); err2 != nil {
+			os.Remove(tmp)
+		}
+		return fmt.Errorf("Transcode(%s, %s): %w", src, dst, err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		os.Remove(tmp)
 		return fmt.Errorf("Transcode(%s, %s): %v", src, dst, err)
 	}
 	log.Printf("Transcode(%s) done", src)
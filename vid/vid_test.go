@@ -0,0 +1,87 @@
+// Copyright 2017 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package vid
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maruel/serve-mp4/vid/ffmpeg"
+	"github.com/maruel/serve-mp4/vid/ffmpeg/ffmpegtest"
+)
+
+func TestIdentify_attachment(t *testing.T) {
+	// The fonts used by the ASS subtitles are attached to the file.
+	v := identify(t, "h264_ass.mkv", "eng")
+	if len(v.Subtitles) != 1 || v.Subtitles[0].Codec != "ass" || !v.Subtitles[0].IsText() {
+		t.Fatalf("unexpected subtitles %#v", v.Subtitles)
+	}
+	ffmpeg.Default = &ffmpegtest.Fake{}
+	d := t.TempDir()
+	src := filepath.Join(d, "in.mkv")
+	if err := os.WriteFile(src, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ExtractSubtitles(context.Background(), src, filepath.Join(d, "in"), v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{filepath.Join(d, "in.2.eng.vtt")}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected subtitles %q", got)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	data := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"eng", []string{"eng"}},
+		{"fre,eng", []string{"fre", "eng"}},
+		{"fre, eng", []string{"fre", "eng"}},
+		{" fre ,, eng, ", []string{"fre", "eng"}},
+	}
+	for i, l := range data {
+		if got := SplitList(l.s); !reflect.DeepEqual(got, l.want) {
+			t.Errorf("#%d: SplitList(%q) = %q, want %q", i, l.s, got, l.want)
+		}
+	}
+}
+
+func TestChooseAudio(t *testing.T) {
+	data := []struct {
+		file  string
+		langs []string
+		index int
+	}{
+		// The default track of the language.
+		{"hevc_hdr10.mkv", []string{"eng"}, 1},
+		{"hevc_hdr10.mkv", []string{"fre"}, 3},
+		// In order of preference.
+		{"hevc_hdr10.mkv", []string{"fre", "eng"}, 3},
+		{"hevc_hdr10.mkv", []string{"ger", "fre"}, 3},
+		// Fallback to the default track.
+		{"hevc_hdr10.mkv", []string{"ger"}, 1},
+		{"hevc_hdr10.mkv", nil, 1},
+		// No default track; the first one of the language.
+		{"mpeg2_interlaced.ts", []string{"eng"}, 2},
+		// Fallback to the first track.
+		{"mpeg2_interlaced.ts", []string{"ger"}, 1},
+		{"mpeg2_interlaced.ts", nil, 1},
+	}
+	for i, l := range data {
+		v := identify(t, l.file, "")
+		if a := chooseAudio(v.Audios, l.langs); a == nil || a.Index != l.index {
+			t.Errorf("#%d: chooseAudio(%s, %q) = %v, want #%d", i, l.file, l.langs, a, l.index)
+		}
+	}
+	if a := chooseAudio(nil, []string{"eng"}); a != nil {
+		t.Errorf("expected no track, got %v", a)
+	}
+}